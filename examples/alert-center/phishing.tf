@@ -0,0 +1,9 @@
+data "gsuite_alerts" "phishing" {
+  filter      = "type = \"User reported phishing\" AND createTime >= \"2018-01-01T00:00:00Z\""
+  order_by    = "createTime desc"
+  max_results = 100
+}
+
+output "phishing_alert_ids" {
+  value = "${data.gsuite_alerts.phishing.alerts.*.alert_id}"
+}
@@ -0,0 +1,10 @@
+resource "gsuite_alert_center_settings" "siem" {
+  notification {
+    topic_name = "projects/security-siem/topics/workspace-alerts"
+  }
+}
+
+resource "gsuite_alert_feedback" "false_positive" {
+  alert_id = "${data.gsuite_alerts.phishing.alerts.0.alert_id}"
+  type     = "NOT_USEFUL"
+}
@@ -0,0 +1,3 @@
+function doGet() {
+  return HtmlService.createHtmlOutput("Hello from the intranet");
+}
@@ -0,0 +1,27 @@
+resource "gsuite_apps_script_project" "intranet" {
+  title               = "Intranet"
+  create_version      = true
+  version_description = "Managed by Terraform"
+
+  file {
+    name   = "appsscript"
+    type   = "JSON"
+    source = "${file("${path.module}/src/appsscript.json")}"
+  }
+
+  file {
+    name   = "Code"
+    type   = "SERVER_JS"
+    source = "${file("${path.module}/src/Code.gs")}"
+  }
+}
+
+resource "gsuite_apps_script_deployment" "intranet" {
+  script_id      = "${gsuite_apps_script_project.intranet.script_id}"
+  version_number = "${gsuite_apps_script_project.intranet.version_number}"
+  description    = "Production"
+}
+
+output "intranet_url" {
+  value = "${gsuite_apps_script_deployment.intranet.web_app_url}"
+}
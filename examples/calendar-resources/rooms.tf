@@ -0,0 +1,16 @@
+data "gsuite_calendar_resources" "large_rooms" {
+  building_id  = "amsterdam-hq"
+  min_capacity = 10
+  features     = ["Video conferencing"]
+  query        = "resourceCategory=CONFERENCE_ROOM"
+}
+
+output "large_room_calendars" {
+  value = "${data.gsuite_calendar_resources.large_rooms.resources}"
+}
+
+data "gsuite_buildings" "all" {}
+
+output "building_floors" {
+  value = "${data.gsuite_buildings.all.buildings}"
+}
@@ -0,0 +1,45 @@
+resource "gsuite_calendar" "devteam" {
+  owner_email = "developer@sillevis.net"
+  summary     = "Developer team"
+  description = "Releases, on-call and team events"
+  time_zone   = "Europe/Amsterdam"
+}
+
+resource "gsuite_calendar_acl" "devteam_writers" {
+  calendar_id = "${gsuite_calendar.devteam.calendar_id}"
+  owner_email = "${gsuite_calendar.devteam.owner_email}"
+  role        = "writer"
+  scope_type  = "group"
+  scope_value = "devteam3@sillevis.net"
+}
+
+resource "gsuite_calendar_acl" "domain_readers" {
+  calendar_id = "${gsuite_calendar.devteam.calendar_id}"
+  owner_email = "${gsuite_calendar.devteam.owner_email}"
+  role        = "reader"
+  scope_type  = "domain"
+  scope_value = "sillevis.net"
+}
+
+# Room calendars are referenced by their directory resource ID
+resource "gsuite_calendar_acl" "boardroom_facilities" {
+  resource_id = "boardroom-1"
+  role        = "writer"
+  scope_type  = "group"
+  scope_value = "facilities@sillevis.net"
+}
+
+resource "gsuite_calendar_event" "standup" {
+  calendar_id = "${gsuite_calendar.devteam.calendar_id}"
+  owner_email = "${gsuite_calendar.devteam.owner_email}"
+  summary     = "Standup"
+  start_time  = "2018-03-05T09:30:00+01:00"
+  end_time    = "2018-03-05T09:45:00+01:00"
+  time_zone   = "Europe/Amsterdam"
+  recurrence  = ["RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"]
+
+  # groups are expanded by calendar
+  attendees = ["devteam3@sillevis.net"]
+
+  create_conference = true
+}
@@ -0,0 +1,17 @@
+data "gsuite_calendars" "developer" {
+  user_email      = "developer@sillevis.net"
+  min_access_role = "owner"
+}
+
+data "gsuite_calendars" "rooms" {
+  resource_calendars = true
+  query              = "resourceCategory=CONFERENCE_ROOM"
+}
+
+resource "gsuite_calendar_acl" "rooms_facilities" {
+  count       = "${length(data.gsuite_calendars.rooms.calendars)}"
+  calendar_id = "${lookup(data.gsuite_calendars.rooms.calendars[count.index], "id")}"
+  role        = "writer"
+  scope_type  = "group"
+  scope_value = "facilities@sillevis.net"
+}
@@ -0,0 +1,16 @@
+resource "gsuite_calendar_user_settings" "developer" {
+  user_email = "developer@sillevis.net"
+  time_zone  = "Europe/Amsterdam"
+
+  default_reminders {
+    method  = "popup"
+    minutes = 10
+  }
+}
+
+# subscribe the new hire to the team calendar
+resource "gsuite_calendar_user_settings" "developer_devteam" {
+  user_email  = "developer@sillevis.net"
+  calendar_id = "${gsuite_calendar.devteam.calendar_id}"
+  color_id    = "9"
+}
@@ -0,0 +1,21 @@
+resource "gsuite_chat_space" "platform" {
+  display_name  = "Platform team"
+  description   = "Day to day chatter of the platform team"
+  guidelines    = "Use threads, page on-call through PagerDuty"
+  history_state = "HISTORY_ON"
+}
+
+output "platform_chat" {
+  value = "${gsuite_chat_space.platform.space_uri}"
+}
+
+resource "gsuite_chat_space_member" "platform_lead" {
+  space      = "${gsuite_chat_space.platform.id}"
+  user_email = "lead@sillevis.net"
+  role       = "ROLE_MANAGER"
+}
+
+resource "gsuite_chat_space_member" "platform_team" {
+  space       = "${gsuite_chat_space.platform.id}"
+  group_email = "platform@sillevis.net"
+}
@@ -0,0 +1,52 @@
+resource "gsuite_chrome_policy" "engineering" {
+  org_unit_id = "id:03ph8a2z1xxxxxx"
+
+  policies {
+    schema_name = "chrome.users.MaxConnectionsPerProxy"
+
+    schema_values = {
+      maxConnectionsPerProxy = "34"
+    }
+  }
+
+  policies {
+    schema_name = "chrome.users.UrlBlocking"
+
+    schema_values = {
+      urlBlocklist = "${jsonencode(list("example.com", "*.example.org"))}"
+    }
+  }
+}
+
+resource "gsuite_chrome_policy" "password_manager_extension" {
+  org_unit_id = "id:03ph8a2z1xxxxxx"
+
+  additional_target_keys = {
+    app_id = "chrome:nngceckbapebfimnlniiiahkandclblb"
+  }
+
+  policies {
+    schema_name = "chrome.users.apps.InstallType"
+
+    schema_values = {
+      appInstallType = "\"FORCED\""
+    }
+  }
+}
+
+resource "gsuite_chrome_policy_file" "wallpaper" {
+  policy_field = "chrome.users.Wallpaper.wallpaperImage"
+  source       = "${path.module}/wallpaper.jpg"
+}
+
+resource "gsuite_chrome_policy" "wallpaper" {
+  org_unit_id = "id:03ph8a2z1xxxxxx"
+
+  policies {
+    schema_name = "chrome.users.Wallpaper"
+
+    schema_values = {
+      wallpaperImage = "${gsuite_chrome_policy_file.wallpaper.value_json}"
+    }
+  }
+}
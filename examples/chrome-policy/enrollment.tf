@@ -0,0 +1,8 @@
+resource "gsuite_chrome_browser_enrollment_token" "engineering" {
+  org_unit_path = "/Engineering"
+}
+
+output "engineering_enrollment_token" {
+  value     = "${gsuite_chrome_browser_enrollment_token.engineering.token}"
+  sensitive = true
+}
@@ -0,0 +1,56 @@
+resource "gsuite_group" "pilot" {
+  email = "chrome-pilot@sillevis.net"
+  name  = "chrome-pilot@sillevis.net"
+}
+
+resource "gsuite_group" "engineering" {
+  email = "engineering@sillevis.net"
+  name  = "engineering@sillevis.net"
+}
+
+resource "gsuite_chrome_policy" "pilot_extension" {
+  group_id = "${gsuite_group.pilot.id}"
+
+  additional_target_keys = {
+    app_id = "chrome:nngceckbapebfimnlniiiahkandclblb"
+  }
+
+  policies {
+    schema_name = "chrome.users.apps.InstallType"
+
+    schema_values = {
+      appInstallType = "\"FORCED\""
+    }
+  }
+}
+
+resource "gsuite_chrome_policy" "engineering_extension" {
+  group_id = "${gsuite_group.engineering.id}"
+
+  additional_target_keys = {
+    app_id = "chrome:nngceckbapebfimnlniiiahkandclblb"
+  }
+
+  policies {
+    schema_name = "chrome.users.apps.InstallType"
+
+    schema_values = {
+      appInstallType = "\"ALLOWED\""
+    }
+  }
+}
+
+resource "gsuite_chrome_policy_group_priority_ordering" "extension" {
+  schema_name = "chrome.users.apps.InstallType"
+  app_id      = "chrome:nngceckbapebfimnlniiiahkandclblb"
+
+  group_ids = [
+    "${gsuite_group.pilot.id}",
+    "${gsuite_group.engineering.id}",
+  ]
+
+  depends_on = [
+    "gsuite_chrome_policy.pilot_extension",
+    "gsuite_chrome_policy.engineering_extension",
+  ]
+}
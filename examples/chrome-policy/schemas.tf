@@ -0,0 +1,15 @@
+data "gsuite_chrome_policy_schemas" "apps" {
+  filter = "name=chrome.users.apps.*"
+}
+
+data "gsuite_chrome_policy_schemas" "proxy" {
+  schema_name = "chrome.users.MaxConnectionsPerProxy"
+}
+
+output "app_policy_names" {
+  value = "${data.gsuite_chrome_policy_schemas.apps.policy_schemas.*.schema_name}"
+}
+
+output "proxy_fields" {
+  value = "${data.gsuite_chrome_policy_schemas.proxy.policy_schemas.0.fields}"
+}
@@ -0,0 +1,8 @@
+variable "kiosk_device_ids" {
+  type = "list"
+}
+
+resource "gsuite_chromeos_device_org_unit" "kiosks" {
+  org_unit_path = "/Devices/Kiosks"
+  device_ids    = ["${var.kiosk_device_ids}"]
+}
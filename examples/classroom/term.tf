@@ -0,0 +1,24 @@
+resource "gsuite_classroom_course" "biology" {
+  name               = "Biology 101"
+  section            = "Fall 2018"
+  room               = "B-204"
+  owner_id           = "teacher@sillevis.net"
+  course_state       = "ACTIVE"
+  archive_on_destroy = true
+}
+
+resource "gsuite_classroom_teacher" "assistant" {
+  course_id = "${gsuite_classroom_course.biology.id}"
+  user_id   = "assistant@sillevis.net"
+}
+
+variable "biology_students" {
+  type    = "list"
+  default = ["student1@sillevis.net", "student2@sillevis.net"]
+}
+
+resource "gsuite_classroom_student" "biology" {
+  count     = "${length(var.biology_students)}"
+  course_id = "${gsuite_classroom_course.biology.id}"
+  user_id   = "${element(var.biology_students, count.index)}"
+}
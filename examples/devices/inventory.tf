@@ -0,0 +1,28 @@
+data "gsuite_mobile_devices" "pending" {
+  query    = "status:pending"
+  order_by = "lastSync"
+}
+
+output "pending_mobile_devices" {
+  value = "${data.gsuite_mobile_devices.pending.devices}"
+}
+
+data "gsuite_chrome_devices" "classrooms" {
+  org_unit_path           = "/Devices/Classrooms"
+  include_child_org_units = true
+  query                   = "status:provisioned"
+}
+
+output "classroom_chromebooks" {
+  value = "${data.gsuite_chrome_devices.classrooms.serial_numbers}"
+}
+
+data "gsuite_printers" "office" {
+  org_unit_id            = "${data.gsuite_org_unit.office.org_unit_id}"
+  include_printer_models = true
+  printer_models_filter  = "manufacturer:Brother"
+}
+
+data "gsuite_org_unit" "office" {
+  org_unit_path = "/Office"
+}
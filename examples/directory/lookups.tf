@@ -0,0 +1,106 @@
+data "gsuite_user" "cto" {
+  user_key = "cto@sillevis.net"
+}
+
+output "cto_org_unit" {
+  value = "${data.gsuite_user.cto.org_unit_path}"
+}
+
+data "gsuite_users" "engineering" {
+  query  = "orgUnitPath='/Eng' isSuspended=false"
+  fields = "id,primaryEmail,orgUnitPath"
+}
+
+resource "gsuite_group_member" "engineering" {
+  count = "${length(data.gsuite_users.engineering.emails)}"
+  group = "engineering@sillevis.net"
+  email = "${element(data.gsuite_users.engineering.emails, count.index)}"
+  role  = "MEMBER"
+}
+
+data "gsuite_group" "everyone" {
+  group_key = "everyone@sillevis.net"
+}
+
+output "everyone_members" {
+  value = "${data.gsuite_group.everyone.direct_members_count}"
+}
+
+data "gsuite_groups" "cto_memberships" {
+  member_key = "${data.gsuite_user.cto.primary_email}"
+}
+
+data "gsuite_group_members" "sre" {
+  group = "sre@sillevis.net"
+  roles = "OWNER,MANAGER"
+}
+
+output "sre_leads" {
+  value = "${data.gsuite_group_members.sre.emails}"
+}
+
+data "gsuite_group_settings" "everyone" {
+  email = "${data.gsuite_group.everyone.email}"
+}
+
+output "everyone_allows_external_members" {
+  value = "${data.gsuite_group_settings.everyone.allow_external_members}"
+}
+
+data "gsuite_org_unit" "sre" {
+  org_unit_path = "/Engineering/SRE"
+}
+
+data "gsuite_org_units" "engineering" {
+  parent_org_unit_path = "/Engineering"
+  type                 = "all_including_parent"
+}
+
+data "gsuite_domains" "all" {}
+
+output "verified_domains" {
+  value = "${data.gsuite_domains.all.verified_domain_names}"
+}
+
+data "gsuite_customer" "current" {}
+
+output "customer_id" {
+  value = "${data.gsuite_customer.current.customer_id}"
+}
+
+data "gsuite_roles" "all" {}
+
+output "help_desk_role_id" {
+  value = "${lookup(data.gsuite_roles.all.role_ids, "_HELP_DESK_ADMIN_ROLE")}"
+}
+
+data "gsuite_role_assignments" "help_desk" {
+  role_id = "${lookup(data.gsuite_roles.all.role_ids, "_HELP_DESK_ADMIN_ROLE")}"
+}
+
+data "gsuite_privileges" "all" {}
+
+data "gsuite_user_schemas" "all" {}
+
+data "gsuite_user_tokens" "cto" {
+  user_key = "${data.gsuite_user.cto.primary_email}"
+}
+
+data "gsuite_user_asps" "cto" {
+  user_key = "${data.gsuite_user.cto.primary_email}"
+}
+
+data "gsuite_group_membership_check" "cto_in_everyone" {
+  group  = "everyone@sillevis.net"
+  member = "${data.gsuite_user.cto.primary_email}"
+}
+
+output "cto_in_everyone" {
+  value = "${data.gsuite_group_membership_check.cto_in_everyone.is_member}"
+}
+
+data "gsuite_deleted_users" "recent" {}
+
+data "gsuite_user_aliases" "cto" {
+  user_key = "${data.gsuite_user.cto.primary_email}"
+}
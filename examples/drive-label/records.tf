@@ -0,0 +1,35 @@
+resource "gsuite_drive_label" "records" {
+  title       = "Records management"
+  description = "Retention classification for company records"
+  label_type  = "ADMIN"
+  published   = true
+
+  field {
+    display_name = "Classification"
+    type         = "selection"
+    required     = true
+    choices      = ["Public", "Internal", "Confidential"]
+  }
+
+  field {
+    display_name = "Retain until"
+    type         = "date"
+  }
+
+  field {
+    display_name = "Record owner"
+    type         = "user"
+  }
+}
+
+resource "gsuite_drive_label_permission" "records_team" {
+  label_id = "${gsuite_drive_label.records.id}"
+  email    = "records@sillevis.net"
+  role     = "APPLIER"
+}
+
+resource "gsuite_drive_label_permission" "everyone" {
+  label_id = "${gsuite_drive_label.records.id}"
+  audience = "audiences/default"
+  role     = "READER"
+}
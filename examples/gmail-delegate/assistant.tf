@@ -0,0 +1,8 @@
+resource "gsuite_gmail_delegate" "assistant" {
+  user_id        = "ceo@sillevis.net"
+  delegate_email = "assistant@sillevis.net"
+}
+
+output "delegate_status" {
+  value = "${gsuite_gmail_delegate.assistant.verification_status}"
+}
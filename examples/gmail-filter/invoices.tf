@@ -0,0 +1,14 @@
+resource "gsuite_gmail_filter" "invoices" {
+  user_id = "finance@sillevis.net"
+
+  criteria {
+    from           = "billing@vendor.example.com"
+    has_attachment = true
+  }
+
+  action {
+    add_label_ids    = ["Label_1"]
+    remove_label_ids = ["INBOX"]
+    forward          = "accounting@sillevis.net"
+  }
+}
@@ -0,0 +1,10 @@
+resource "gsuite_gmail_forwarding_address" "oncall" {
+  user_id          = "alerts@sillevis.net"
+  forwarding_email = "oncall@sillevis.net"
+}
+
+resource "gsuite_gmail_auto_forwarding" "alerts" {
+  user_id       = "alerts@sillevis.net"
+  email_address = "${gsuite_gmail_forwarding_address.oncall.forwarding_email}"
+  disposition   = "archive"
+}
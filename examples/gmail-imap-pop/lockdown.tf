@@ -0,0 +1,5 @@
+resource "gsuite_gmail_imap_pop_settings" "finance" {
+  user_id           = "finance@sillevis.net"
+  imap_enabled      = false
+  pop_access_window = "disabled"
+}
@@ -0,0 +1,25 @@
+resource "gsuite_gmail_label" "escalations" {
+  user_id                 = "support@sillevis.net"
+  name                    = "Escalations"
+  label_list_visibility   = "labelShow"
+  message_list_visibility = "show"
+  background_color        = "#fb4c2f"
+  text_color              = "#ffffff"
+}
+
+resource "gsuite_gmail_label" "escalations_billing" {
+  user_id = "support@sillevis.net"
+  name    = "${gsuite_gmail_label.escalations.name}/Billing"
+}
+
+resource "gsuite_gmail_filter" "billing" {
+  user_id = "support@sillevis.net"
+
+  criteria {
+    subject = "invoice"
+  }
+
+  action {
+    add_label_ids = ["${gsuite_gmail_label.escalations_billing.label_id}"]
+  }
+}
@@ -0,0 +1,20 @@
+provider "gsuite" {
+  credentials             = "service-account.json"
+  impersonated_user_email = "admin@sillevis.net"
+}
+
+resource "gsuite_gmail_send_as" "support" {
+  user_id          = "developer@sillevis.net"
+  send_as_email    = "support@sillevis.net"
+  display_name     = "Sillevis Support"
+  reply_to_address = "support@sillevis.net"
+  signature        = "<b>Sillevis Support</b><br>support@sillevis.net"
+  treat_as_alias   = true
+}
+
+# The primary address always exists, its signature can be managed too
+resource "gsuite_gmail_send_as" "primary" {
+  user_id       = "developer@sillevis.net"
+  send_as_email = "developer@sillevis.net"
+  signature     = "<b>Developer</b><br>Sillevis"
+}
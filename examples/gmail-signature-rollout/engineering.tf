@@ -0,0 +1,14 @@
+resource "gsuite_gmail_signature_rollout" "engineering" {
+  org_unit_path = "/Engineering"
+
+  # placeholders use {{...}} so terraform does not interpolate them
+  signature_template = <<EOT
+<b>{{full_name}}</b><br>
+{{title}}, {{department}}<br>
+{{phone}} | {{email}}
+EOT
+}
+
+output "engineering_users" {
+  value = "${gsuite_gmail_signature_rollout.engineering.users}"
+}
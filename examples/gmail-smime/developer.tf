@@ -0,0 +1,9 @@
+variable "smime_password" {}
+
+resource "gsuite_gmail_smime_certificate" "developer" {
+  user_id                = "developer@sillevis.net"
+  send_as_email          = "developer@sillevis.net"
+  pkcs12_file            = "certs/developer.p12"
+  encrypted_key_password = "${var.smime_password}"
+  is_default             = true
+}
@@ -0,0 +1,8 @@
+resource "gsuite_gmail_vacation_responder" "support" {
+  user_id            = "support@sillevis.net"
+  subject            = "Closed for the holidays"
+  body_html          = "<p>We are closed until January 2nd, we will get back to you then.</p>"
+  restrict_to_domain = false
+  start_time         = "2018-12-24T00:00:00Z"
+  end_time           = "2019-01-02T00:00:00Z"
+}
@@ -0,0 +1,15 @@
+resource "gsuite_group" "announcements" {
+  email = "announcements@sillevis.net"
+  name  = "announcements@sillevis.net"
+}
+
+variable "archived_messages" {
+  type    = "list"
+  default = ["2017-01-kickoff.eml", "2017-06-summer-party.eml"]
+}
+
+resource "gsuite_group_archive_message" "history" {
+  count       = "${length(var.archived_messages)}"
+  group_email = "${gsuite_group.announcements.email}"
+  source      = "${path.module}/messages/${element(var.archived_messages, count.index)}"
+}
@@ -0,0 +1,10 @@
+data "gsuite_license_products_and_skus" "workspace" {
+  product = "Google Workspace"
+}
+
+output "business_plus" {
+  value = {
+    product_id = "${lookup(data.gsuite_license_products_and_skus.workspace.product_ids, "Google Workspace Business Plus")}"
+    sku_id     = "${lookup(data.gsuite_license_products_and_skus.workspace.sku_ids, "Google Workspace Business Plus")}"
+  }
+}
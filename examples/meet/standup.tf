@@ -0,0 +1,12 @@
+resource "gsuite_meet_space" "standup" {
+  access_type = "TRUSTED"
+}
+
+resource "gsuite_meet_space" "board" {
+  access_type        = "RESTRICTED"
+  entry_point_access = "CREATOR_APP_ONLY"
+}
+
+output "standup_link" {
+  value = "${gsuite_meet_space.standup.meeting_uri}"
+}
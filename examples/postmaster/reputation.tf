@@ -0,0 +1,22 @@
+variable "date" {
+  description = "Day to report on, YYYY-MM-DD"
+}
+
+data "gsuite_postmaster_domains" "all" {}
+
+data "gsuite_postmaster_traffic_stats" "newsletter" {
+  domain = "news.sillevis.net"
+  date   = "${var.date}"
+}
+
+output "postmaster_domains" {
+  value = "${data.gsuite_postmaster_domains.all.domains}"
+}
+
+output "newsletter_reputation" {
+  value = "${data.gsuite_postmaster_traffic_stats.newsletter.domain_reputation}"
+}
+
+output "newsletter_spam_ratio" {
+  value = "${data.gsuite_postmaster_traffic_stats.newsletter.user_reported_spam_ratio}"
+}
@@ -0,0 +1,9 @@
+data "gsuite_drive_activity" "external_shares" {
+  event_name = "change_user_access"
+  visibility = "shared_externally"
+  start_time = "2018-02-01T00:00:00.000Z"
+}
+
+output "external_shares" {
+  value = "${data.gsuite_drive_activity.external_shares.activities}"
+}
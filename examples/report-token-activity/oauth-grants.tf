@@ -0,0 +1,8 @@
+data "gsuite_token_activity" "grants" {
+  event_name = "authorize"
+  client_id  = "1234567890.apps.googleusercontent.com"
+}
+
+output "grants" {
+  value = "${data.gsuite_token_activity.grants.activities}"
+}
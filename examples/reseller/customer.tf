@@ -0,0 +1,22 @@
+resource "gsuite_reseller_customer" "example" {
+  customer_domain = "example-customer.com"
+  alternate_email = "it@example-customer.org"
+  phone_number    = "+31 20 123 4567"
+
+  postal_address {
+    contact_name      = "Jane Doe"
+    organization_name = "Example Customer B.V."
+    address_line1     = "Herengracht 1"
+    locality          = "Amsterdam"
+    postal_code       = "1015 BA"
+    country_code      = "NL"
+  }
+}
+
+resource "gsuite_reseller_subscription" "business" {
+  customer_id     = "${gsuite_reseller_customer.example.customer_id}"
+  sku_id          = "1010020027"
+  plan_name       = "ANNUAL_MONTHLY_PAY"
+  number_of_seats = 25
+  renewal_type    = "AUTO_RENEW_MONTHLY_PAY"
+}
@@ -0,0 +1,21 @@
+resource "gsuite_domain_shared_contact" "acme_support" {
+  domain      = "sillevis.net"
+  given_name  = "ACME"
+  family_name = "Support"
+  notes       = "24/7 support line for the ACME contract"
+
+  email {
+    address = "support@acme.com"
+    primary = true
+  }
+
+  phone_number {
+    number  = "+31 20 123 4567"
+    primary = true
+  }
+
+  organization {
+    name       = "ACME Corporation"
+    department = "Customer Support"
+  }
+}
@@ -0,0 +1,7 @@
+data "gsuite_shared_drives" "finance" {
+  query = "name contains 'Finance'"
+}
+
+output "finance_drives" {
+  value = "${data.gsuite_shared_drives.finance.drives}"
+}
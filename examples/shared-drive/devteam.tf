@@ -0,0 +1,58 @@
+resource "gsuite_shared_drive" "devteam" {
+  name = "Developer team"
+
+  restrictions {
+    admin_managed_restrictions      = true
+    copy_requires_writer_permission = true
+    domain_users_only               = true
+    drive_members_only              = true
+  }
+
+  use_domain_admin_access = true
+}
+
+resource "gsuite_shared_drive_permission" "devteam_managers" {
+  drive_id      = "${gsuite_shared_drive.devteam.id}"
+  type          = "group"
+  email_address = "devteam3@sillevis.net"
+  role          = "fileOrganizer"
+}
+
+resource "gsuite_drive_folder" "contracts" {
+  name      = "01-Contracts"
+  parent_id = "${gsuite_shared_drive.devteam.id}"
+}
+
+resource "gsuite_drive_folder" "finance" {
+  name      = "02-Finance"
+  parent_id = "${gsuite_shared_drive.devteam.id}"
+}
+
+resource "gsuite_drive_file" "expense_policy" {
+  name       = "Expense policy"
+  parent_id  = "${gsuite_drive_folder.finance.id}"
+  source     = "policies/expenses.html"
+  convert_to = "document"
+}
+
+resource "gsuite_drive_file" "contract_template" {
+  name      = "Contract template.pdf"
+  parent_id = "${gsuite_drive_folder.contracts.id}"
+  source    = "templates/contract.pdf"
+}
+
+resource "gsuite_drive_permission" "expense_policy_domain" {
+  file_id              = "${gsuite_drive_file.expense_policy.id}"
+  type                 = "domain"
+  domain               = "sillevis.net"
+  role                 = "reader"
+  allow_file_discovery = true
+}
+
+resource "gsuite_drive_permission" "contracts_auditor" {
+  file_id         = "${gsuite_drive_folder.contracts.id}"
+  type            = "user"
+  email_address   = "auditor@example.com"
+  role            = "reader"
+  expiration_time = "2018-06-30T00:00:00Z"
+}
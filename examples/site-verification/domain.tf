@@ -0,0 +1,16 @@
+data "gsuite_site_verification_token" "sillevis" {
+  domain = "sillevis.com"
+}
+
+resource "google_dns_record_set" "verification" {
+  managed_zone = "sillevis-com"
+  name         = "sillevis.com."
+  type         = "TXT"
+  ttl          = 300
+  rrdatas      = ["\"${data.gsuite_site_verification_token.sillevis.token}\""]
+}
+
+resource "gsuite_site_verification" "sillevis" {
+  domain     = "sillevis.com"
+  depends_on = ["google_dns_record_set.verification"]
+}
@@ -0,0 +1,48 @@
+resource "gsuite_vault_matter" "litigation" {
+  name        = "ACME vs. Sillevis"
+  description = "Preservation for the ACME contract dispute"
+}
+
+resource "gsuite_vault_hold" "mail" {
+  matter_id  = "${gsuite_vault_matter.litigation.id}"
+  name       = "Sales mail"
+  corpus     = "MAIL"
+  accounts   = ["chase@sillevis.net", "sales-lead@sillevis.net"]
+  terms      = "from:acme.com OR to:acme.com"
+  start_time = "2017-01-01T00:00:00Z"
+}
+
+resource "gsuite_vault_hold" "drive" {
+  matter_id                  = "${gsuite_vault_matter.litigation.id}"
+  name                       = "Sales drive"
+  corpus                     = "DRIVE"
+  org_unit_id                = "id:03ph8a2z1xxxxxx"
+  include_shared_drive_files = true
+}
+
+resource "gsuite_vault_export" "mail" {
+  matter_id  = "${gsuite_vault_matter.litigation.id}"
+  name       = "Sales mail export"
+  corpus     = "MAIL"
+  data_scope = "HELD_DATA"
+  accounts   = ["${gsuite_vault_hold.mail.accounts}"]
+  terms      = "${gsuite_vault_hold.mail.terms}"
+  region     = "EUROPE"
+}
+
+output "mail_export_files" {
+  value = "${gsuite_vault_export.mail.files}"
+}
+
+resource "gsuite_vault_saved_query" "acme_mail" {
+  matter_id    = "${gsuite_vault_matter.litigation.id}"
+  display_name = "All ACME correspondence"
+  corpus       = "MAIL"
+  terms        = "from:acme.com OR to:acme.com"
+}
+
+resource "gsuite_vault_matter_permission" "counsel" {
+  matter_id = "${gsuite_vault_matter.litigation.id}"
+  account   = "counsel@sillevis.net"
+  role      = "COLLABORATOR"
+}
@@ -0,0 +1,41 @@
+package gsuite
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/oauth2/jwt"
+)
+
+func TestDelegatedClientReuse(t *testing.T) {
+	c := &Config{
+		jwtConfig:  &jwt.Config{Email: "sa@example.iam.gserviceaccount.com"},
+		httpClient: cleanhttp.DefaultClient(),
+	}
+
+	client := func(subject string, scopes ...string) interface{} {
+		client, err := c.delegatedClient(subject, scopes...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+
+	jane := client("jane@example.com", gmailSettingsScopes...)
+	if client("jane@example.com", gmailSettingsScopes...) != jane {
+		t.Error("a second client was created for the same subject and scopes")
+	}
+	reversed := []string{}
+	for i := len(gmailSettingsScopes) - 1; i >= 0; i-- {
+		reversed = append(reversed, gmailSettingsScopes[i])
+	}
+	if client("jane@example.com", reversed...) != jane {
+		t.Error("a second client was created for the same scopes in another order")
+	}
+	if client("john@example.com", gmailSettingsScopes...) == jane {
+		t.Error("the client of another subject was reused")
+	}
+	if client("jane@example.com", calendarScopes...) == jane {
+		t.Error("the client of other scopes was reused")
+	}
+}
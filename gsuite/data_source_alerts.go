@@ -0,0 +1,169 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			// e.g. "type = \"User reported phishing\" AND createTime >= \"2018-01-01T00:00:00Z\""
+			"filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// createTime asc, createTime desc, updateTime asc or updateTime desc
+			"order_by": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// stop paging once this many alerts have been read, 0 reads all
+			"max_results": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			"alerts": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alert_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deleted": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assignee": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_investigation_tool_link": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// the type specific alert data, JSON encoded
+						"data": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	alertCenterSvc, err := config.alertCenterService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	maxResults := d.Get("max_results").(int)
+	alerts := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := alertCenterSvc.Alerts.List()
+		if v, ok := d.GetOk("filter"); ok {
+			call = call.Filter(v.(string))
+		}
+		if v, ok := d.GetOk("order_by"); ok {
+			call = call.OrderBy(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing alerts: %s", err)
+		}
+
+		for _, alert := range resp.Alerts {
+			var status, severity, assignee string
+			if alert.Metadata != nil {
+				status = alert.Metadata.Status
+				severity = alert.Metadata.Severity
+				assignee = alert.Metadata.Assignee
+			}
+
+			alerts = append(alerts, map[string]interface{}{
+				"alert_id":                         alert.AlertId,
+				"type":                             alert.Type,
+				"source":                           alert.Source,
+				"create_time":                      alert.CreateTime,
+				"start_time":                       alert.StartTime,
+				"end_time":                         alert.EndTime,
+				"update_time":                      alert.UpdateTime,
+				"deleted":                          alert.Deleted,
+				"status":                           status,
+				"severity":                         severity,
+				"assignee":                         assignee,
+				"security_investigation_tool_link": alert.SecurityInvestigationToolLink,
+				"data":                             string(alert.Data),
+			})
+		}
+
+		if maxResults > 0 && len(alerts) >= maxResults {
+			alerts = alerts[:maxResults]
+			break
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d alerts", len(alerts))
+	d.SetId(time.Now().UTC().String())
+	d.Set("alerts", alerts)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceBuildings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBuildingsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"buildings": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"building_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"building_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floor_names": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"latitude": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"longitude": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"address_lines": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"locality": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"postal_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBuildingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	buildings := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.Resources.Buildings.List(customerID(d, config))
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing buildings: %s", err)
+		}
+
+		for _, building := range resp.Buildings {
+			flattened := map[string]interface{}{
+				"building_id":   building.BuildingId,
+				"building_name": building.BuildingName,
+				"description":   building.Description,
+				"floor_names":   building.FloorNames,
+			}
+			if building.Coordinates != nil {
+				flattened["latitude"] = building.Coordinates.Latitude
+				flattened["longitude"] = building.Coordinates.Longitude
+			}
+			if building.Address != nil {
+				flattened["address_lines"] = building.Address.AddressLines
+				flattened["locality"] = building.Address.Locality
+				flattened["postal_code"] = building.Address.PostalCode
+				flattened["region_code"] = building.Address.RegionCode
+			}
+			buildings = append(buildings, flattened)
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d buildings", len(buildings))
+	d.SetId(time.Now().UTC().String())
+	d.Set("buildings", buildings)
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceCalendarResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCalendarResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"building_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"min_capacity": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// only list resources that have all of these features
+			"features": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// additional directory query, e.g. "resourceCategory=CONFERENCE_ROOM",
+			// combined with the filters above
+			"query": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"resources": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// the calendar ID of the resource
+						"resource_email": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"generated_resource_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// CONFERENCE_ROOM or OTHER
+						"resource_category": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"building_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floor_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floor_section": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capacity": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"features": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func calendarResourcesQuery(d *schema.ResourceData) string {
+	clauses := []string{}
+	if v, ok := d.GetOk("building_id"); ok {
+		clauses = append(clauses, fmt.Sprintf("buildingId=%s", v.(string)))
+	}
+	if v, ok := d.GetOk("min_capacity"); ok {
+		clauses = append(clauses, fmt.Sprintf("capacity>=%d", v.(int)))
+	}
+	for _, feature := range d.Get("features").([]interface{}) {
+		clauses = append(clauses, fmt.Sprintf("featureInstances.feature.name:%q", feature.(string)))
+	}
+	if v, ok := d.GetOk("query"); ok {
+		clauses = append(clauses, v.(string))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+func dataSourceCalendarResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query := calendarResourcesQuery(d)
+	resources := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.Resources.Calendars.List(customerID(d, config))
+		if query != "" {
+			call = call.Query(query)
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing calendar resources: %s", err)
+		}
+
+		for _, resource := range resp.Items {
+			resources = append(resources, map[string]interface{}{
+				"resource_id":             resource.ResourceId,
+				"resource_email":          resource.ResourceEmail,
+				"resource_name":           resource.ResourceName,
+				"generated_resource_name": resource.GeneratedResourceName,
+				"resource_description":    resource.ResourceDescription,
+				"resource_type":           resource.ResourceType,
+				"resource_category":       resource.ResourceCategory,
+				"building_id":             resource.BuildingId,
+				"floor_name":              resource.FloorName,
+				"floor_section":           resource.FloorSection,
+				"capacity":                int(resource.Capacity),
+				"features":                flattenCalendarResourceFeatures(resource.FeatureInstances),
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d calendar resources", len(resources))
+	d.SetId(time.Now().UTC().String())
+	d.Set("resources", resources)
+
+	return nil
+}
+
+// flattenCalendarResourceFeatures extracts the feature names from the
+// untyped feature instances of a calendar resource.
+func flattenCalendarResourceFeatures(featureInstances interface{}) []string {
+	features := []string{}
+	instances, ok := featureInstances.([]interface{})
+	if !ok {
+		return features
+	}
+	for _, instance := range instances {
+		entry, ok := instance.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		feature, ok := entry["feature"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := feature["name"].(string); ok {
+			features = append(features, name)
+		}
+	}
+	return features
+}
@@ -0,0 +1,202 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceCalendars() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCalendarsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// the user whose calendar list is returned, defaults to
+			// impersonated_user_email of the provider
+			"user_email": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"resource_calendars"},
+			},
+
+			// freeBusyReader, reader, writer or owner
+			"min_access_role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"show_hidden": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// list resource calendars (rooms) from the directory instead
+			"resource_calendars": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"user_email"},
+			},
+
+			// directory query for resource calendars, e.g.
+			// "resourceCategory=CONFERENCE_ROOM"
+			"query": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"calendars": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_zone": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_role": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCalendarsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	var calendars []map[string]interface{}
+	var err error
+	if d.Get("resource_calendars").(bool) {
+		calendars, err = listResourceCalendars(ctx, config, customerID(d, config), d.Get("query").(string))
+	} else {
+		calendars, err = listCalendarListEntries(ctx, config, d)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Found %d calendars", len(calendars))
+	d.SetId(time.Now().UTC().String())
+	d.Set("calendars", calendars)
+
+	return nil
+}
+
+func listCalendarListEntries(ctx context.Context, config *Config, d *schema.ResourceData) ([]map[string]interface{}, error) {
+	userEmail := d.Get("user_email").(string)
+	if userEmail == "" {
+		userEmail = config.ImpersonatedUserEmail
+	}
+
+	calendarSvc, err := config.calendarService(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := calendarSvc.CalendarList.List().ShowHidden(d.Get("show_hidden").(bool))
+		if v, ok := d.GetOk("min_access_role"); ok {
+			call = call.MinAccessRole(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing calendars of %s: %s", userEmail, err)
+		}
+
+		for _, entry := range resp.Items {
+			calendars = append(calendars, map[string]interface{}{
+				"id":          entry.Id,
+				"summary":     entry.Summary,
+				"description": entry.Description,
+				"time_zone":   entry.TimeZone,
+				"access_role": entry.AccessRole,
+				"primary":     entry.Primary,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			return calendars, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func listResourceCalendars(ctx context.Context, config *Config, customerID, query string) ([]map[string]interface{}, error) {
+	directorySvc, err := config.directoryService(directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.Resources.Calendars.List(customerID)
+		if query != "" {
+			call = call.Query(query)
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing calendar resources: %s", err)
+		}
+
+		for _, resource := range resp.Items {
+			calendars = append(calendars, map[string]interface{}{
+				"id":          resource.ResourceEmail,
+				"summary":     resource.ResourceName,
+				"description": resource.ResourceDescription,
+				"resource_id": resource.ResourceId,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			return calendars, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,177 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceChromeDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceChromeDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// e.g. "status:provisioned sync:2018-01-01.."
+			"query": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only list the devices of this org unit
+			"org_unit_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// also list the devices of child org units of org_unit_path
+			"include_child_org_units": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"serial_numbers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"devices": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// ACTIVE, DEPROVISIONED, DISABLED, ...
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"org_unit_path": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"annotated_user": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"annotated_location": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"annotated_asset_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notes": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_sync": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// the end of automatic updates, milliseconds since the epoch
+						"auto_update_expiration": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChromeDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryDeviceChromeosScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	devices := []map[string]interface{}{}
+	serialNumbers := []string{}
+	pageToken := ""
+	for {
+		call := directorySvc.Chromeosdevices.List(customerID(d, config)).Projection("FULL")
+		if v, ok := d.GetOk("query"); ok {
+			call = call.Query(v.(string))
+		}
+		if v, ok := d.GetOk("org_unit_path"); ok {
+			call = call.OrgUnitPath(v.(string))
+			if d.Get("include_child_org_units").(bool) {
+				call = call.IncludeChildOrgunits(true)
+			}
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing chrome devices: %s", err)
+		}
+
+		for _, device := range resp.Chromeosdevices {
+			devices = append(devices, map[string]interface{}{
+				"device_id":              device.DeviceId,
+				"serial_number":          device.SerialNumber,
+				"model":                  device.Model,
+				"status":                 device.Status,
+				"org_unit_path":          device.OrgUnitPath,
+				"os_version":             device.OsVersion,
+				"platform_version":       device.PlatformVersion,
+				"annotated_user":         device.AnnotatedUser,
+				"annotated_location":     device.AnnotatedLocation,
+				"annotated_asset_id":     device.AnnotatedAssetId,
+				"notes":                  device.Notes,
+				"last_sync":              device.LastSync,
+				"auto_update_expiration": int(device.AutoUpdateExpiration),
+			})
+			serialNumbers = append(serialNumbers, device.SerialNumber)
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d chrome devices", len(devices))
+	d.SetId(time.Now().UTC().String())
+	d.Set("serial_numbers", serialNumbers)
+	d.Set("devices", devices)
+
+	return nil
+}
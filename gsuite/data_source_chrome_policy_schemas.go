@@ -0,0 +1,175 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+)
+
+func dataSourceChromePolicySchemas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceChromePolicySchemasRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// fetch a single schema, e.g. chrome.users.MaxConnectionsPerProxy
+			"schema_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filter"},
+			},
+
+			// e.g. "name=chrome.users.apps.*"
+			"filter": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"schema_name"},
+			},
+
+			"policy_schemas": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schema_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"support_uri": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// orgunits and/or groups
+						"valid_target_resources": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"additional_target_key_names": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"fields": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									// the allowed values of enum fields
+									"known_values": &schema.Schema{
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenChromePolicySchema(policySchema *chromepolicy.GoogleChromePolicyVersionsV1PolicySchema) map[string]interface{} {
+	keyNames := []string{}
+	for _, key := range policySchema.AdditionalTargetKeyNames {
+		keyNames = append(keyNames, key.Key)
+	}
+
+	fields := []map[string]interface{}{}
+	for _, field := range policySchema.FieldDescriptions {
+		knownValues := map[string]string{}
+		for _, value := range field.KnownValueDescriptions {
+			knownValues[value.Value] = value.Description
+		}
+
+		fields = append(fields, map[string]interface{}{
+			"field":        field.Field,
+			"description":  field.Description,
+			"known_values": knownValues,
+		})
+	}
+
+	return map[string]interface{}{
+		"schema_name":                 policySchema.SchemaName,
+		"policy_description":          policySchema.PolicyDescription,
+		"support_uri":                 policySchema.SupportUri,
+		"valid_target_resources":      policySchema.ValidTargetResources,
+		"additional_target_key_names": keyNames,
+		"fields":                      fields,
+	}
+}
+
+func dataSourceChromePolicySchemasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policySchemas := []map[string]interface{}{}
+
+	if v, ok := d.GetOk("schema_name"); ok {
+		name := fmt.Sprintf("%s/policySchemas/%s", chromePolicyCustomer(d, config), v.(string))
+		policySchema, err := chromePolicySvc.Customers.PolicySchemas.Get(name).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error reading chrome policy schema %s: %s", v.(string), err)
+		}
+		policySchemas = append(policySchemas, flattenChromePolicySchema(policySchema))
+	} else {
+		pageToken := ""
+		for {
+			call := chromePolicySvc.Customers.PolicySchemas.List(chromePolicyCustomer(d, config))
+			if v, ok := d.GetOk("filter"); ok {
+				call = call.Filter(v.(string))
+			}
+			if pageToken != "" {
+				call = call.PageToken(pageToken)
+			}
+
+			resp, err := call.Context(ctx).Do()
+			if err != nil {
+				return diag.Errorf("Error listing chrome policy schemas: %s", err)
+			}
+
+			for _, policySchema := range resp.PolicySchemas {
+				policySchemas = append(policySchemas, flattenChromePolicySchema(policySchema))
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+
+	log.Printf("[INFO] Found %d chrome policy schemas", len(policySchemas))
+	d.SetId(time.Now().UTC().String())
+	d.Set("policy_schemas", policySchemas)
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package gsuite
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCustomerRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer ID, the customer of the impersonated user by default
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"customer_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"alternate_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// ISO 639 language code
+			"language": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"postal_address": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_line1": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_line2": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_line3": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locality": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"postal_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCustomerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	customerKey := customerID(d, config)
+
+	customer, err := config.directory.Customers.Get(customerKey).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading customer %s: %s", customerKey, err)
+	}
+
+	postalAddress := []map[string]interface{}{}
+	if address := customer.PostalAddress; address != nil {
+		postalAddress = append(postalAddress, map[string]interface{}{
+			"contact_name":      address.ContactName,
+			"organization_name": address.OrganizationName,
+			"address_line1":     address.AddressLine1,
+			"address_line2":     address.AddressLine2,
+			"address_line3":     address.AddressLine3,
+			"locality":          address.Locality,
+			"region":            address.Region,
+			"postal_code":       address.PostalCode,
+			"country_code":      address.CountryCode,
+		})
+	}
+
+	d.SetId(customer.Id)
+	d.Set("customer_id", customer.Id)
+	d.Set("customer_domain", customer.CustomerDomain)
+	d.Set("alternate_email", customer.AlternateEmail)
+	d.Set("phone_number", customer.PhoneNumber)
+	d.Set("language", customer.Language)
+	d.Set("creation_time", customer.CustomerCreationTime)
+	d.Set("postal_address", postalAddress)
+
+	return nil
+}
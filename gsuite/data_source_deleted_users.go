@@ -0,0 +1,95 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceDeletedUsers lists the users deleted in the last 20 days, which
+// can still be undeleted.
+func dataSourceDeletedUsers() *schema.Resource {
+	attributes := dataSourceUserAttributes()
+	attributes["deletion_time"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDeletedUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// list the deleted users of this domain instead of the whole customer
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"emails": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: attributes,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeletedUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	users := []map[string]interface{}{}
+	emails := []string{}
+	pageToken := ""
+	for {
+		call := config.directory.Users.List().ShowDeleted("true")
+		if v, ok := d.GetOk("domain"); ok {
+			call = call.Domain(v.(string))
+		} else {
+			call = call.Customer(customerID(d, config))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing deleted users: %s", err)
+		}
+
+		for _, user := range resp.Users {
+			flattened := flattenDataSourceUser(user)
+			flattened["deletion_time"] = user.DeletionTime
+			users = append(users, flattened)
+			emails = append(emails, user.PrimaryEmail)
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d deleted users", len(users))
+	d.SetId(time.Now().UTC().String())
+	d.Set("emails", emails)
+	d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// the verified domains and domain aliases
+			"verified_domain_names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"primary_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domains": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_primary": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"verified": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						// milliseconds since the epoch
+						"creation_time": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain_aliases": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain_alias_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"verified": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"creation_time": &schema.Schema{
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryDomainReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := directorySvc.Domains.List(customerID(d, config)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing domains: %s", err)
+	}
+
+	domains := []map[string]interface{}{}
+	verified := []string{}
+	primary := ""
+	for _, domain := range resp.Domains {
+		aliases := []map[string]interface{}{}
+		for _, alias := range domain.DomainAliases {
+			aliases = append(aliases, map[string]interface{}{
+				"domain_alias_name": alias.DomainAliasName,
+				"verified":          alias.Verified,
+				"creation_time":     int(alias.CreationTime),
+			})
+			if alias.Verified {
+				verified = append(verified, alias.DomainAliasName)
+			}
+		}
+
+		domains = append(domains, map[string]interface{}{
+			"domain_name":    domain.DomainName,
+			"is_primary":     domain.IsPrimary,
+			"verified":       domain.Verified,
+			"creation_time":  int(domain.CreationTime),
+			"domain_aliases": aliases,
+		})
+		if domain.Verified {
+			verified = append(verified, domain.DomainName)
+		}
+		if domain.IsPrimary {
+			primary = domain.DomainName
+		}
+	}
+
+	log.Printf("[INFO] Found %d domains", len(domains))
+	d.SetId(time.Now().UTC().String())
+	d.Set("verified_domain_names", verified)
+	d.Set("primary_domain_name", primary)
+	d.Set("domains", domains)
+
+	return nil
+}
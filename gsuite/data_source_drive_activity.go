@@ -0,0 +1,55 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDriveActivity() *schema.Resource {
+	dsSchema := activityQuerySchema()
+
+	dsSchema["doc_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	// visibility is a shorthand for the most common audit filter, e.g.
+	// "shared_externally" or "people_with_link".
+	dsSchema["visibility"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDriveActivityRead,
+		Schema:      dsSchema,
+	}
+}
+
+func dataSourceDriveActivityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	filters := []string{}
+	if v, ok := d.GetOk("doc_id"); ok {
+		filters = append(filters, fmt.Sprintf("doc_id==%s", v.(string)))
+	}
+	if v, ok := d.GetOk("visibility"); ok {
+		filters = append(filters, fmt.Sprintf("visibility==%s", v.(string)))
+	}
+
+	activities, err := listActivities(ctx, config, d, "drive", filters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Found %d drive activities", len(activities))
+	d.SetId(time.Now().UTC().String())
+	d.Set("activities", flattenActivities(activities))
+
+	return nil
+}
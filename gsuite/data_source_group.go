@@ -0,0 +1,107 @@
+package gsuite
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// dataSourceGroupAttributes are the computed attributes of a group, shared by
+// the gsuite_group and gsuite_groups data sources.
+func dataSourceGroupAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"email": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"direct_members_count": &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+
+		"admin_created": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"aliases": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"non_editable_aliases": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func flattenDataSourceGroup(group *directory.Group) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   group.Id,
+		"email":                group.Email,
+		"name":                 group.Name,
+		"description":          group.Description,
+		"direct_members_count": int(group.DirectMembersCount),
+		"admin_created":        group.AdminCreated,
+		"aliases":              group.Aliases,
+		"non_editable_aliases": group.NonEditableAliases,
+	}
+}
+
+func dataSourceGroup() *schema.Resource {
+	attributes := dataSourceGroupAttributes()
+	delete(attributes, "id")
+
+	// the email, an alias or the unique ID of the group
+	attributes["group_key"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRead,
+
+		Schema: attributes,
+	}
+}
+
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	groupKey := d.Get("group_key").(string)
+	group, err := config.directory.Groups.Get(groupKey).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading group %s: %s", groupKey, err)
+	}
+
+	d.SetId(group.Id)
+	for k, v := range flattenDataSourceGroup(group) {
+		if k == "id" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
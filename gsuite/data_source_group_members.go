@@ -0,0 +1,129 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			// the email or unique ID of the group
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// comma separated OWNER, MANAGER and MEMBER, all roles by default
+			"roles": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// also list the members of nested groups
+			"include_derived_membership": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"emails": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// OWNER, MANAGER or MEMBER
+						"role": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// USER, GROUP, CUSTOMER or EXTERNAL
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delivery_settings": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	group := d.Get("group").(string)
+	members := []map[string]interface{}{}
+	emails := []string{}
+	pageToken := ""
+	for {
+		call := config.directory.Members.List(group)
+		if v, ok := d.GetOk("roles"); ok {
+			call = call.Roles(v.(string))
+		}
+		if d.Get("include_derived_membership").(bool) {
+			call = call.IncludeDerivedMembership(true)
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing members of group %s: %s", group, err)
+		}
+
+		for _, member := range resp.Members {
+			members = append(members, map[string]interface{}{
+				"id":                member.Id,
+				"email":             member.Email,
+				"role":              member.Role,
+				"type":              member.Type,
+				"status":            member.Status,
+				"delivery_settings": member.DeliverySettings,
+			})
+			if member.Email != "" {
+				emails = append(emails, member.Email)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d members of group %s", len(members), group)
+	d.SetId(group)
+	d.Set("emails", emails)
+	d.Set("members", members)
+
+	return nil
+}
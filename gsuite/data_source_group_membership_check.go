@@ -0,0 +1,53 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGroupMembershipCheck tells whether a user is a member of a group,
+// directly or through nested groups.
+func dataSourceGroupMembershipCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupMembershipCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			// the email or unique ID of the group
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// the email or unique ID of the member, the API does not support
+			// group keys or external members here
+			"member": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"is_member": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGroupMembershipCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	group := d.Get("group").(string)
+	member := d.Get("member").(string)
+	resp, err := config.directory.Members.HasMember(group, member).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error checking membership of %s in group %s: %s", member, group, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", group, member))
+	d.Set("is_member", resp.IsMember)
+
+	return nil
+}
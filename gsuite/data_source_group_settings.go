@@ -0,0 +1,225 @@
+package gsuite
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGroupSettings reads the Groups Settings of a group. The API
+// returns booleans as "true" or "false" strings, they are exposed as bools.
+func dataSourceGroupSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// ANYONE_CAN_JOIN, ALL_IN_DOMAIN_CAN_JOIN, INVITED_CAN_JOIN or CAN_REQUEST_TO_JOIN
+			"who_can_join": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// ALL_IN_DOMAIN_CAN_VIEW, ALL_MEMBERS_CAN_VIEW, ALL_MANAGERS_CAN_VIEW or ALL_OWNERS_CAN_VIEW
+			"who_can_view_membership": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// ANYONE_CAN_VIEW, ALL_IN_DOMAIN_CAN_VIEW, ALL_MEMBERS_CAN_VIEW, ALL_MANAGERS_CAN_VIEW or ALL_OWNERS_CAN_VIEW
+			"who_can_view_group": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// NONE_CAN_POST, ALL_MANAGERS_CAN_POST, ALL_MEMBERS_CAN_POST, ALL_OWNERS_CAN_POST, ALL_IN_DOMAIN_CAN_POST or ANYONE_CAN_POST
+			"who_can_post_message": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// ANYONE_CAN_DISCOVER, ALL_IN_DOMAIN_CAN_DISCOVER or ALL_MEMBERS_CAN_DISCOVER
+			"who_can_discover_group": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"who_can_leave_group": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"who_can_contact_owner": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"who_can_moderate_members": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"who_can_moderate_content": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"who_can_assist_content": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"message_moderation_level": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// ALLOW, MODERATE, SILENTLY_MODERATE or REJECT
+			"spam_moderation_level": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"reply_to": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"custom_reply_to": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"custom_footer_text": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_message_deny_notification_text": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_language": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// DEFAULT_SELF or GROUP
+			"default_sender": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"allow_external_members": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"allow_web_posting": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_archived": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"archive_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"members_can_post_as_the_group": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"include_custom_footer": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"send_message_deny_notification": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"include_in_global_address_list": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enable_collaborative_inbox": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"max_message_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGroupSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	config.checkAPIEnabled("groups_settings")
+
+	email := d.Get("email").(string)
+	settings, err := config.groupsSettings.Groups.Get(email).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading settings of group %s: %s", email, err)
+	}
+
+	d.SetId(email)
+	d.Set("name", settings.Name)
+	d.Set("description", settings.Description)
+	d.Set("max_message_bytes", int(settings.MaxMessageBytes))
+	d.Set("who_can_join", settings.WhoCanJoin)
+	d.Set("who_can_view_membership", settings.WhoCanViewMembership)
+	d.Set("who_can_view_group", settings.WhoCanViewGroup)
+	d.Set("who_can_post_message", settings.WhoCanPostMessage)
+	d.Set("who_can_discover_group", settings.WhoCanDiscoverGroup)
+	d.Set("who_can_leave_group", settings.WhoCanLeaveGroup)
+	d.Set("who_can_contact_owner", settings.WhoCanContactOwner)
+	d.Set("who_can_moderate_members", settings.WhoCanModerateMembers)
+	d.Set("who_can_moderate_content", settings.WhoCanModerateContent)
+	d.Set("who_can_assist_content", settings.WhoCanAssistContent)
+	d.Set("message_moderation_level", settings.MessageModerationLevel)
+	d.Set("spam_moderation_level", settings.SpamModerationLevel)
+	d.Set("reply_to", settings.ReplyTo)
+	d.Set("custom_reply_to", settings.CustomReplyTo)
+	d.Set("custom_footer_text", settings.CustomFooterText)
+	d.Set("default_message_deny_notification_text", settings.DefaultMessageDenyNotificationText)
+	d.Set("primary_language", settings.PrimaryLanguage)
+	d.Set("default_sender", settings.DefaultSender)
+	d.Set("allow_external_members", settings.AllowExternalMembers == "true")
+	d.Set("allow_web_posting", settings.AllowWebPosting == "true")
+	d.Set("is_archived", settings.IsArchived == "true")
+	d.Set("archive_only", settings.ArchiveOnly == "true")
+	d.Set("members_can_post_as_the_group", settings.MembersCanPostAsTheGroup == "true")
+	d.Set("include_custom_footer", settings.IncludeCustomFooter == "true")
+	d.Set("send_message_deny_notification", settings.SendMessageDenyNotification == "true")
+	d.Set("include_in_global_address_list", settings.IncludeInGlobalAddressList == "true")
+	d.Set("enable_collaborative_inbox", settings.EnableCollaborativeInbox == "true")
+
+	return nil
+}
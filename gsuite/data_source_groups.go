@@ -0,0 +1,105 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// list the groups of this domain instead of the whole customer
+			"domain": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"member_key"},
+			},
+
+			// only list the groups this user or group is a direct member of
+			"member_key": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"domain"},
+			},
+
+			// e.g. "email:eng-*", not supported together with member_key
+			"query": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"member_key"},
+			},
+
+			"emails": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceGroupAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	groups := []map[string]interface{}{}
+	emails := []string{}
+	pageToken := ""
+	for {
+		call := config.directory.Groups.List()
+		if v, ok := d.GetOk("member_key"); ok {
+			call = call.UserKey(v.(string))
+		} else if v, ok := d.GetOk("domain"); ok {
+			call = call.Domain(v.(string))
+		} else {
+			call = call.Customer(customerID(d, config))
+		}
+		if v, ok := d.GetOk("query"); ok {
+			call = call.Query(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing groups: %s", err)
+		}
+
+		for _, group := range resp.Groups {
+			groups = append(groups, flattenDataSourceGroup(group))
+			emails = append(emails, group.Email)
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d groups", len(groups))
+	d.SetId(time.Now().UTC().String())
+	d.Set("emails", emails)
+	d.Set("groups", groups)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// licenseSku is an entry of the product and SKU catalog of the Enterprise
+// License Manager API. The API has no endpoint listing the catalog.
+type licenseSku struct {
+	ProductID   string
+	ProductName string
+	SkuID       string
+	SkuName     string
+}
+
+var licenseSkus = []licenseSku{
+	{"Google-Apps", "Google Workspace", "1010020027", "Google Workspace Business Starter"},
+	{"Google-Apps", "Google Workspace", "1010020028", "Google Workspace Business Standard"},
+	{"Google-Apps", "Google Workspace", "1010020025", "Google Workspace Business Plus"},
+	{"Google-Apps", "Google Workspace", "1010060003", "Google Workspace Enterprise Essentials"},
+	{"Google-Apps", "Google Workspace", "1010020026", "Google Workspace Enterprise Standard"},
+	{"Google-Apps", "Google Workspace", "1010020020", "Google Workspace Enterprise Plus"},
+	{"Google-Apps", "Google Workspace", "1010060001", "Google Workspace Essentials"},
+	{"Google-Apps", "Google Workspace", "1010020030", "Google Workspace Frontline"},
+	{"Google-Apps", "Google Workspace", "Google-Apps-Unlimited", "G Suite Business"},
+	{"Google-Apps", "Google Workspace", "Google-Apps-For-Business", "G Suite Basic"},
+	{"Google-Apps", "Google Workspace", "Google-Apps-Lite", "G Suite Lite"},
+	{"Google-Apps", "Google Workspace", "Google-Apps-For-Postini", "Google Apps Message Security"},
+	{"101034", "Google Workspace Archived User", "1010340001", "Google Workspace Enterprise Plus - Archived User"},
+	{"101034", "Google Workspace Archived User", "1010340002", "G Suite Business - Archived User"},
+	{"101031", "Google Workspace for Education", "1010310005", "Google Workspace for Education Standard"},
+	{"101031", "Google Workspace for Education", "1010310006", "Google Workspace for Education Standard (Staff)"},
+	{"101031", "Google Workspace for Education", "1010310008", "Google Workspace for Education Plus"},
+	{"101031", "Google Workspace for Education", "1010310009", "Google Workspace for Education Plus (Staff)"},
+	{"101031", "Google Workspace for Education", "1010310010", "Google Workspace for Education: Teaching and Learning Upgrade"},
+	{"101001", "Cloud Identity", "1010010001", "Cloud Identity"},
+	{"101005", "Cloud Identity Premium", "1010050001", "Cloud Identity Premium"},
+	{"101033", "Google Voice", "1010330003", "Google Voice Starter"},
+	{"101033", "Google Voice", "1010330004", "Google Voice Standard"},
+	{"101033", "Google Voice", "1010330002", "Google Voice Premier"},
+	{"Google-Vault", "Google Vault", "Google-Vault", "Google Vault"},
+	{"Google-Vault", "Google Vault", "Google-Vault-Former-Employee", "Google Vault - Former Employee"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-20GB", "Google Drive storage 20 GB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-50GB", "Google Drive storage 50 GB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-200GB", "Google Drive storage 200 GB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-400GB", "Google Drive storage 400 GB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-1TB", "Google Drive storage 1 TB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-2TB", "Google Drive storage 2 TB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-4TB", "Google Drive storage 4 TB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-8TB", "Google Drive storage 8 TB"},
+	{"Google-Drive-storage", "Google Drive storage", "Google-Drive-storage-16TB", "Google Drive storage 16 TB"},
+}
+
+func dataSourceLicenseProductsAndSkus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLicenseProductsAndSkusRead,
+
+		Schema: map[string]*schema.Schema{
+			// only return the SKUs of this product, by ID or name
+			"product": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// SKU names to SKU IDs, e.g. sku_ids["Google Workspace Business Plus"]
+			"sku_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// SKU names to the ID of their product
+			"product_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"skus": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sku_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sku_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLicenseProductsAndSkusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	product := d.Get("product").(string)
+
+	skus := []map[string]interface{}{}
+	skuIDs := map[string]interface{}{}
+	productIDs := map[string]interface{}{}
+	for _, sku := range licenseSkus {
+		if product != "" && sku.ProductID != product && !strings.EqualFold(sku.ProductName, product) {
+			continue
+		}
+
+		skus = append(skus, map[string]interface{}{
+			"product_id":   sku.ProductID,
+			"product_name": sku.ProductName,
+			"sku_id":       sku.SkuID,
+			"sku_name":     sku.SkuName,
+		})
+		skuIDs[sku.SkuName] = sku.SkuID
+		productIDs[sku.SkuName] = sku.ProductID
+	}
+
+	if len(skus) == 0 {
+		return diag.Errorf("Error reading license SKUs: unknown product %s", product)
+	}
+
+	d.SetId(fmt.Sprintf("license-skus/%s", product))
+	d.Set("sku_ids", skuIDs)
+	d.Set("product_ids", productIDs)
+	d.Set("skus", skus)
+
+	return nil
+}
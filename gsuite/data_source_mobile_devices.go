@@ -0,0 +1,169 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceMobileDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMobileDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// e.g. "status:approved os:Android"
+			"query": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// deviceId, email, lastSync, model, name, os, status or type
+			"order_by": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// ASCENDING or DESCENDING
+			"sort_order": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"devices": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// ANDROID, IOS_SYNC, GOOGLE_SYNC, ...
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// APPROVED, PENDING, BLOCKED, WIPING, ...
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compromised_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"emails": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"names": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"first_sync": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_sync": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMobileDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryDeviceMobileReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	devices := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.Mobiledevices.List(customerID(d, config)).Projection("FULL")
+		if v, ok := d.GetOk("query"); ok {
+			call = call.Query(v.(string))
+		}
+		if v, ok := d.GetOk("order_by"); ok {
+			call = call.OrderBy(v.(string))
+		}
+		if v, ok := d.GetOk("sort_order"); ok {
+			call = call.SortOrder(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing mobile devices: %s", err)
+		}
+
+		for _, device := range resp.Mobiledevices {
+			devices = append(devices, map[string]interface{}{
+				"resource_id":        device.ResourceId,
+				"device_id":          device.DeviceId,
+				"serial_number":      device.SerialNumber,
+				"model":              device.Model,
+				"manufacturer":       device.Manufacturer,
+				"os":                 device.Os,
+				"type":               device.Type,
+				"status":             device.Status,
+				"compromised_status": device.DeviceCompromisedStatus,
+				"emails":             device.Email,
+				"names":              device.Name,
+				"first_sync":         device.FirstSync,
+				"last_sync":          device.LastSync,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d mobile devices", len(devices))
+	d.SetId(time.Now().UTC().String())
+	d.Set("devices", devices)
+
+	return nil
+}
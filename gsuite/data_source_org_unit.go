@@ -0,0 +1,107 @@
+package gsuite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// dataSourceOrgUnitAttributes are the computed attributes of an org unit,
+// shared by the gsuite_org_unit and gsuite_org_units data sources.
+func dataSourceOrgUnitAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"org_unit_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"org_unit_path": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"parent_org_unit_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"parent_org_unit_path": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"block_inheritance": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDataSourceOrgUnit(orgUnit *directory.OrgUnit) map[string]interface{} {
+	return map[string]interface{}{
+		"org_unit_id":          orgUnit.OrgUnitId,
+		"org_unit_path":        orgUnit.OrgUnitPath,
+		"name":                 orgUnit.Name,
+		"description":          orgUnit.Description,
+		"parent_org_unit_id":   orgUnit.ParentOrgUnitId,
+		"parent_org_unit_path": orgUnit.ParentOrgUnitPath,
+		"block_inheritance":    orgUnit.BlockInheritance,
+	}
+}
+
+func dataSourceOrgUnit() *schema.Resource {
+	attributes := dataSourceOrgUnitAttributes()
+
+	// the full path, e.g. /Engineering/SRE, or the org unit ID prefixed
+	// with id:
+	attributes["org_unit_path"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	// the customer to act on instead of the provider's customer_id
+	attributes["customer_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceOrgUnitRead,
+
+		Schema: attributes,
+	}
+}
+
+func dataSourceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	// The API expects paths without the leading slash
+	orgUnitPath := d.Get("org_unit_path").(string)
+	orgUnit, err := config.directory.Orgunits.Get(customerID(d, config), strings.TrimPrefix(orgUnitPath, "/")).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading org unit %s: %s", orgUnitPath, err)
+	}
+
+	d.SetId(orgUnit.OrgUnitId)
+	for k, v := range flattenDataSourceOrgUnit(orgUnit) {
+		if k == "org_unit_path" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrgUnits() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgUnitsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// the org unit to list below, the root by default
+			"parent_org_unit_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+
+			// children, all or all_including_parent
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "all",
+			},
+
+			"org_unit_paths": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"org_units": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceOrgUnitAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrgUnitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parent := d.Get("parent_org_unit_path").(string)
+	listType := d.Get("type").(string)
+
+	// The API takes allIncludingParent, keep the attribute snake cased
+	apiType := listType
+	if listType == "all_including_parent" {
+		apiType = "allIncludingParent"
+	}
+
+	call := config.directory.Orgunits.List(customerID(d, config)).Type(apiType)
+	if parent != "/" {
+		call = call.OrgUnitPath(strings.TrimPrefix(parent, "/"))
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing org units below %s: %s", parent, err)
+	}
+
+	orgUnits := []map[string]interface{}{}
+	paths := []string{}
+	for _, orgUnit := range resp.OrganizationUnits {
+		orgUnits = append(orgUnits, flattenDataSourceOrgUnit(orgUnit))
+		paths = append(paths, orgUnit.OrgUnitPath)
+	}
+
+	log.Printf("[INFO] Found %d org units below %s", len(orgUnits), parent)
+	d.SetId(fmt.Sprintf("%s/%s", listType, parent))
+	d.Set("org_unit_paths", paths)
+	d.Set("org_units", orgUnits)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePostmasterDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePostmasterDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			"domains": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// OWNER or READER
+						"permission": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePostmasterDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	postmasterToolsSvc, err := config.postmasterToolsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domains := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := postmasterToolsSvc.Domains.List()
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing postmaster domains: %s", err)
+		}
+
+		for _, domain := range resp.Domains {
+			domains = append(domains, map[string]interface{}{
+				"domain":      strings.TrimPrefix(domain.Name, "domains/"),
+				"permission":  domain.Permission,
+				"create_time": domain.CreateTime,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d postmaster domains", len(domains))
+	d.SetId(time.Now().UTC().String())
+	d.Set("domains", domains)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePostmasterTrafficStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePostmasterTrafficStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// YYYYMMDD or YYYY-MM-DD, stats are only kept for the past 120 days
+			"date": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// HIGH, MEDIUM, LOW or BAD
+			"domain_reputation": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_reported_spam_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"spf_success_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"dkim_success_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"dmarc_success_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"inbound_encryption_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"outbound_encryption_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"ip_reputations": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reputation": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"sample_ips": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"delivery_errors": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// PERMANENT_ERROR or TEMPORARY_ERROR
+						"error_class": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_ratio": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePostmasterTrafficStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	postmasterToolsSvc, err := config.postmasterToolsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get("domain").(string)
+	date := strings.Replace(d.Get("date").(string), "-", "", -1)
+	name := fmt.Sprintf("domains/%s/trafficStats/%s", domain, date)
+
+	stats, err := postmasterToolsSvc.Domains.TrafficStats.Get(name).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading postmaster traffic stats %s: %s", name, err)
+	}
+
+	ipReputations := []map[string]interface{}{}
+	for _, ipReputation := range stats.IpReputations {
+		ipReputations = append(ipReputations, map[string]interface{}{
+			"reputation": ipReputation.Reputation,
+			"ip_count":   int(ipReputation.IpCount),
+			"sample_ips": ipReputation.SampleIps,
+		})
+	}
+
+	deliveryErrors := []map[string]interface{}{}
+	for _, deliveryError := range stats.DeliveryErrors {
+		deliveryErrors = append(deliveryErrors, map[string]interface{}{
+			"error_class": deliveryError.ErrorClass,
+			"error_type":  deliveryError.ErrorType,
+			"error_ratio": deliveryError.ErrorRatio,
+		})
+	}
+
+	d.SetId(name)
+	d.Set("domain_reputation", stats.DomainReputation)
+	d.Set("user_reported_spam_ratio", stats.UserReportedSpamRatio)
+	d.Set("spf_success_ratio", stats.SpfSuccessRatio)
+	d.Set("dkim_success_ratio", stats.DkimSuccessRatio)
+	d.Set("dmarc_success_ratio", stats.DmarcSuccessRatio)
+	d.Set("inbound_encryption_ratio", stats.InboundEncryptionRatio)
+	d.Set("outbound_encryption_ratio", stats.OutboundEncryptionRatio)
+	d.Set("ip_reputations", ipReputations)
+	d.Set("delivery_errors", deliveryErrors)
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourcePrinters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrintersRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only list the printers of this org unit, without inherited ones
+			"org_unit_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// e.g. "display_name:Lobby*"
+			"filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// also read the supported printer models into printer_models
+			"include_printer_models": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// e.g. "manufacturer:Brother"
+			"printer_models_filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"printers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"make_and_model": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"org_unit_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use_driverless_config": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"printer_models": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// the value to use as make_and_model of a printer
+						"make_and_model": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrintersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminChromePrintersReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parent := "customers/" + customerID(d, config)
+	printers := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.Customers.Chrome.Printers.List(parent)
+		if v, ok := d.GetOk("org_unit_id"); ok {
+			call = call.OrgUnitId(v.(string))
+		}
+		if v, ok := d.GetOk("filter"); ok {
+			call = call.Filter(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing printers: %s", err)
+		}
+
+		for _, printer := range resp.Printers {
+			printers = append(printers, map[string]interface{}{
+				"id":                    printer.Id,
+				"display_name":          printer.DisplayName,
+				"description":           printer.Description,
+				"make_and_model":        printer.MakeAndModel,
+				"uri":                   printer.Uri,
+				"org_unit_id":           printer.OrgUnitId,
+				"use_driverless_config": printer.UseDriverlessConfig,
+				"create_time":           printer.CreateTime,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	printerModels := []map[string]interface{}{}
+	if d.Get("include_printer_models").(bool) {
+		pageToken = ""
+		for {
+			call := directorySvc.Customers.Chrome.Printers.ListPrinterModels(parent)
+			if v, ok := d.GetOk("printer_models_filter"); ok {
+				call = call.Filter(v.(string))
+			}
+			if pageToken != "" {
+				call = call.PageToken(pageToken)
+			}
+
+			resp, err := call.Context(ctx).Do()
+			if err != nil {
+				return diag.Errorf("Error listing printer models: %s", err)
+			}
+
+			for _, model := range resp.PrinterModels {
+				printerModels = append(printerModels, map[string]interface{}{
+					"display_name":   model.DisplayName,
+					"manufacturer":   model.Manufacturer,
+					"make_and_model": model.MakeAndModel,
+				})
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+
+	log.Printf("[INFO] Found %d printers and %d printer models", len(printers), len(printerModels))
+	d.SetId(time.Now().UTC().String())
+	d.Set("printers", printers)
+	d.Set("printer_models", printerModels)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// dataSourcePrivileges lists the privileges custom roles can be built from.
+// The privilege tree is flattened, child privileges refer to their parent
+// through parent_privilege_name.
+func dataSourcePrivileges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrivilegesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"privileges": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"privilege_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_ou_scopable": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						// empty for top level privileges
+						"parent_privilege_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenPrivileges(privileges []*directory.Privilege, parent string) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, privilege := range privileges {
+		result = append(result, map[string]interface{}{
+			"service_id":            privilege.ServiceId,
+			"service_name":          privilege.ServiceName,
+			"privilege_name":        privilege.PrivilegeName,
+			"is_ou_scopable":        privilege.IsOuScopable,
+			"parent_privilege_name": parent,
+		})
+		result = append(result, flattenPrivileges(privilege.ChildPrivileges, privilege.PrivilegeName)...)
+	}
+	return result
+}
+
+func dataSourcePrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := directorySvc.Privileges.List(customerID(d, config)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing privileges: %s", err)
+	}
+
+	privileges := flattenPrivileges(resp.Items, "")
+	log.Printf("[INFO] Found %d privileges", len(privileges))
+	d.SetId(time.Now().UTC().String())
+	d.Set("privileges", privileges)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceRoleAssignments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRoleAssignmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only list the assignments of this user, by email or ID
+			"user_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only list the assignments of this role
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"role_assignments": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_assignment_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// the ID of the user, group or service account
+						"assigned_to": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// user or group
+						"assignee_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// CUSTOMER or ORG_UNIT
+						"scope_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"org_unit_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"condition": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoleAssignmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignments := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.RoleAssignments.List(customerID(d, config))
+		if v, ok := d.GetOk("user_key"); ok {
+			call = call.UserKey(v.(string))
+		}
+		if v, ok := d.GetOk("role_id"); ok {
+			call = call.RoleId(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing role assignments: %s", err)
+		}
+
+		for _, assignment := range resp.Items {
+			assignments = append(assignments, map[string]interface{}{
+				"role_assignment_id": strconv.FormatInt(assignment.RoleAssignmentId, 10),
+				"role_id":            strconv.FormatInt(assignment.RoleId, 10),
+				"assigned_to":        assignment.AssignedTo,
+				"assignee_type":      assignment.AssigneeType,
+				"scope_type":         assignment.ScopeType,
+				"org_unit_id":        assignment.OrgUnitId,
+				"condition":          assignment.Condition,
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d role assignments", len(assignments))
+	d.SetId(time.Now().UTC().String())
+	d.Set("role_assignments", assignments)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+func dataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// role names to role IDs, e.g. role_ids["_HELP_DESK_ADMIN_ROLE"]
+			"role_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"roles": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_system_role": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_super_admin_role": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"privileges": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"privilege_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryRolemanagementReadonlyScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roles := []map[string]interface{}{}
+	roleIDs := map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := directorySvc.Roles.List(customerID(d, config))
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing roles: %s", err)
+		}
+
+		for _, role := range resp.Items {
+			privileges := []map[string]interface{}{}
+			for _, privilege := range role.RolePrivileges {
+				privileges = append(privileges, map[string]interface{}{
+					"service_id":     privilege.ServiceId,
+					"privilege_name": privilege.PrivilegeName,
+				})
+			}
+
+			// Role IDs are int64, keep them as strings so they survive the
+			// state round trip on 32-bit platforms
+			roleID := strconv.FormatInt(role.RoleId, 10)
+			roles = append(roles, map[string]interface{}{
+				"role_id":             roleID,
+				"role_name":           role.RoleName,
+				"role_description":    role.RoleDescription,
+				"is_system_role":      role.IsSystemRole,
+				"is_super_admin_role": role.IsSuperAdminRole,
+				"privileges":          privileges,
+			})
+			roleIDs[role.RoleName] = roleID
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d roles", len(roles))
+	d.SetId(time.Now().UTC().String())
+	d.Set("role_ids", roleIDs)
+	d.Set("roles", roles)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSharedDrives() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSharedDrivesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the user to act as instead of the provider's
+			// impersonated_user_email
+			"impersonated_user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Drive search query, e.g. "name contains 'Finance'"
+			"query": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// list every shared drive in the domain instead of only those the
+			// impersonated user is a member of
+			"use_domain_admin_access": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"drives": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hidden": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"color_rgb": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restrictions": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin_managed_restrictions": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"copy_requires_writer_permission": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"domain_users_only": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"drive_members_only": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSharedDrivesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	drives := []map[string]interface{}{}
+	pageToken := ""
+	for {
+		call := driveSvc.Drives.List().UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool))
+		if v, ok := d.GetOk("query"); ok {
+			call = call.Q(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing shared drives: %s", err)
+		}
+
+		for _, sharedDrive := range resp.Drives {
+			drives = append(drives, map[string]interface{}{
+				"id":           sharedDrive.Id,
+				"name":         sharedDrive.Name,
+				"hidden":       sharedDrive.Hidden,
+				"color_rgb":    sharedDrive.ColorRgb,
+				"created_time": sharedDrive.CreatedTime,
+				"restrictions": flattenSharedDriveRestrictions(sharedDrive.Restrictions),
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d shared drives", len(drives))
+	d.SetId(time.Now().UTC().String())
+	d.Set("drives", drives)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	siteverification "google.golang.org/api/siteverification/v1"
+)
+
+// dataSourceSiteVerificationToken fetches the token to publish before a
+// gsuite_site_verification can succeed.
+func dataSourceSiteVerificationToken() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteVerificationTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// DNS_TXT or DNS_CNAME
+			"verification_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "DNS_TXT",
+			},
+
+			// the TXT record value, or the CNAME record name and target
+			// separated by a space
+			"token": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteVerificationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	siteVerificationSvc, err := config.siteVerificationService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get("domain").(string)
+	method := d.Get("verification_method").(string)
+	resp, err := siteVerificationSvc.WebResource.GetToken(&siteverification.SiteVerificationWebResourceGettokenRequest{
+		Site: &siteverification.SiteVerificationWebResourceGettokenRequestSite{
+			Identifier: domain,
+			Type:       "INET_DOMAIN",
+		},
+		VerificationMethod: method,
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error fetching site verification token for %s: %s", domain, err)
+	}
+
+	log.Printf("[INFO] Fetched %s site verification token for %s", resp.Method, domain)
+	d.SetId(fmt.Sprintf("%s/%s", domain, method))
+	d.Set("token", resp.Token)
+
+	return nil
+}
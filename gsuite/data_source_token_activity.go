@@ -0,0 +1,53 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTokenActivity() *schema.Resource {
+	dsSchema := activityQuerySchema()
+
+	dsSchema["client_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	dsSchema["app_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceTokenActivityRead,
+		Schema:      dsSchema,
+	}
+}
+
+func dataSourceTokenActivityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	filters := []string{}
+	if v, ok := d.GetOk("client_id"); ok {
+		filters = append(filters, fmt.Sprintf("client_id==%s", v.(string)))
+	}
+	if v, ok := d.GetOk("app_name"); ok {
+		filters = append(filters, fmt.Sprintf("app_name==%s", v.(string)))
+	}
+
+	activities, err := listActivities(ctx, config, d, "token", filters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Found %d token activities", len(activities))
+	d.SetId(time.Now().UTC().String())
+	d.Set("activities", flattenActivities(activities))
+
+	return nil
+}
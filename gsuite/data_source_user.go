@@ -0,0 +1,208 @@
+package gsuite
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// dataSourceUserAttributes are the computed attributes of a user, shared by
+// the gsuite_user and gsuite_users data sources.
+func dataSourceUserAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"primary_email": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"name": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"family_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"full_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"given_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"org_unit_path": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"aliases": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"non_editable_aliases": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"is_admin": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"is_delegated_admin": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"is_suspended": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"suspension_reason": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"is_archived": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"2s_enforced": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"2s_enrolled": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"is_mailbox_setup": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"include_in_global_list": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"customer_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"creation_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"last_login_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"thumbnail_photo_url": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		// the JSON encoded fields of each custom schema, only read with the
+		// full projection
+		"custom_schemas": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func flattenDataSourceUser(user *directory.User) map[string]interface{} {
+	name := []map[string]interface{}{}
+	if user.Name != nil {
+		name = append(name, flattenUserName(user.Name))
+	}
+
+	customSchemas := map[string]interface{}{}
+	for schemaName, fields := range user.CustomSchemas {
+		customSchemas[schemaName] = string(fields)
+	}
+
+	return map[string]interface{}{
+		"id":                     user.Id,
+		"primary_email":          user.PrimaryEmail,
+		"name":                   name,
+		"org_unit_path":          user.OrgUnitPath,
+		"aliases":                user.Aliases,
+		"non_editable_aliases":   user.NonEditableAliases,
+		"is_admin":               user.IsAdmin,
+		"is_delegated_admin":     user.IsDelegatedAdmin,
+		"is_suspended":           user.Suspended,
+		"suspension_reason":      user.SuspensionReason,
+		"is_archived":            user.Archived,
+		"2s_enforced":            user.IsEnforcedIn2Sv,
+		"2s_enrolled":            user.IsEnrolledIn2Sv,
+		"is_mailbox_setup":       user.IsMailboxSetup,
+		"include_in_global_list": user.IncludeInGlobalAddressList,
+		"customer_id":            user.CustomerId,
+		"creation_time":          user.CreationTime,
+		"last_login_time":        user.LastLoginTime,
+		"thumbnail_photo_url":    user.ThumbnailPhotoUrl,
+		"custom_schemas":         customSchemas,
+	}
+}
+
+func dataSourceUser() *schema.Resource {
+	attributes := dataSourceUserAttributes()
+	delete(attributes, "id")
+
+	// the primary email, an alias or the unique ID of the user
+	attributes["user_key"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceUserRead,
+
+		Schema: attributes,
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userKey := d.Get("user_key").(string)
+	user, err := config.directory.Users.Get(userKey).Projection("full").Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading user %s: %s", userKey, err)
+	}
+
+	d.SetId(user.Id)
+	for k, v := range flattenDataSourceUser(user) {
+		if k == "id" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
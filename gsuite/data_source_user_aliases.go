@@ -0,0 +1,74 @@
+package gsuite
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserAliases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			// the primary email, an alias or the unique ID of the user
+			"user_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"primary_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aliases": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// aliases outside the primary domain, e.g. of domain aliases
+			"non_editable_aliases": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceUserAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userKey := d.Get("user_key").(string)
+	user, err := config.directory.Users.Get(userKey).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading user %s: %s", userKey, err)
+	}
+
+	resp, err := config.directory.Users.Aliases.List(user.Id).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing aliases of user %s: %s", userKey, err)
+	}
+
+	// Aliases are returned untyped
+	aliases := []string{}
+	for _, entry := range resp.Aliases {
+		alias, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if email, ok := alias["alias"].(string); ok {
+			aliases = append(aliases, email)
+		}
+	}
+
+	d.SetId(user.Id)
+	d.Set("primary_email", user.PrimaryEmail)
+	d.Set("aliases", aliases)
+	d.Set("non_editable_aliases", user.NonEditableAliases)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserAsps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserAspsRead,
+
+		Schema: map[string]*schema.Schema{
+			// the primary email, an alias or the unique ID of the user
+			"user_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"asps": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// milliseconds since the epoch
+						"creation_time": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						// milliseconds since the epoch, 0 when never used
+						"last_time_used": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserAspsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userKey := d.Get("user_key").(string)
+	resp, err := config.directory.Asps.List(userKey).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing application specific passwords of user %s: %s", userKey, err)
+	}
+
+	asps := []map[string]interface{}{}
+	for _, asp := range resp.Items {
+		asps = append(asps, map[string]interface{}{
+			"code_id":        int(asp.CodeId),
+			"name":           asp.Name,
+			"creation_time":  int(asp.CreationTime),
+			"last_time_used": int(asp.LastTimeUsed),
+		})
+	}
+
+	log.Printf("[INFO] Found %d application specific passwords of user %s", len(asps), userKey)
+	d.SetId(userKey)
+	d.Set("asps", asps)
+
+	return nil
+}
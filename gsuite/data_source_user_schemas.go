@@ -0,0 +1,123 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserSchemas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserSchemasRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"schemas": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schema_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"field_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									// STRING, INT64, BOOL, DOUBLE, EMAIL, PHONE or DATE
+									"field_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"multi_valued": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									// ALL_DOMAIN_USERS or ADMINS_AND_SELF
+									"read_access_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"indexed": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserSchemasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resp, err := config.directory.Schemas.List(customerID(d, config)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing user schemas: %s", err)
+	}
+
+	schemas := []map[string]interface{}{}
+	for _, userSchema := range resp.Schemas {
+		fields := []map[string]interface{}{}
+		for _, field := range userSchema.Fields {
+			// Fields are indexed unless explicitly disabled
+			indexed := field.Indexed == nil || *field.Indexed
+			fields = append(fields, map[string]interface{}{
+				"field_id":         field.FieldId,
+				"field_name":       field.FieldName,
+				"field_type":       field.FieldType,
+				"display_name":     field.DisplayName,
+				"multi_valued":     field.MultiValued,
+				"read_access_type": field.ReadAccessType,
+				"indexed":          indexed,
+			})
+		}
+
+		schemas = append(schemas, map[string]interface{}{
+			"schema_id":    userSchema.SchemaId,
+			"schema_name":  userSchema.SchemaName,
+			"display_name": userSchema.DisplayName,
+			"fields":       fields,
+		})
+	}
+
+	log.Printf("[INFO] Found %d user schemas", len(schemas))
+	d.SetId(time.Now().UTC().String())
+	d.Set("schemas", schemas)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserTokens() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserTokensRead,
+
+		Schema: map[string]*schema.Schema{
+			// the primary email, an alias or the unique ID of the user
+			"user_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tokens": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_text": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anonymous": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"native_app": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"scopes": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userKey := d.Get("user_key").(string)
+	resp, err := config.directory.Tokens.List(userKey).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error listing tokens of user %s: %s", userKey, err)
+	}
+
+	tokens := []map[string]interface{}{}
+	for _, token := range resp.Items {
+		tokens = append(tokens, map[string]interface{}{
+			"client_id":    token.ClientId,
+			"display_text": token.DisplayText,
+			"anonymous":    token.Anonymous,
+			"native_app":   token.NativeApp,
+			"scopes":       token.Scopes,
+		})
+	}
+
+	log.Printf("[INFO] Found %d tokens of user %s", len(tokens), userKey)
+	d.SetId(userKey)
+	d.Set("tokens", tokens)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// list the users of this domain instead of the whole customer
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// e.g. "orgUnitPath='/Eng' isSuspended=false"
+			"query": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// basic, custom or full
+			"projection": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "basic",
+			},
+
+			// comma separated custom schemas to read with the custom projection
+			"custom_field_mask": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// email, familyName or givenName
+			"order_by": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// ASCENDING or DESCENDING
+			"sort_order": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// partial response selector of the user fields to read, e.g.
+			// "id,primaryEmail,orgUnitPath". Unselected attributes are empty.
+			"fields": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"emails": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceUserAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	users := []map[string]interface{}{}
+	emails := []string{}
+	pageToken := ""
+	for {
+		call := config.directory.Users.List().Projection(d.Get("projection").(string))
+		if v, ok := d.GetOk("domain"); ok {
+			call = call.Domain(v.(string))
+		} else {
+			call = call.Customer(customerID(d, config))
+		}
+		if v, ok := d.GetOk("query"); ok {
+			call = call.Query(v.(string))
+		}
+		if v, ok := d.GetOk("custom_field_mask"); ok {
+			call = call.CustomFieldMask(v.(string))
+		}
+		if v, ok := d.GetOk("order_by"); ok {
+			call = call.OrderBy(v.(string))
+		}
+		if v, ok := d.GetOk("sort_order"); ok {
+			call = call.SortOrder(v.(string))
+		}
+		if v, ok := d.GetOk("fields"); ok {
+			call = call.Fields(googleapi.Field(fmt.Sprintf("nextPageToken,users(%s)", v.(string))))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing users: %s", err)
+		}
+
+		for _, user := range resp.Users {
+			users = append(users, flattenDataSourceUser(user))
+			emails = append(emails, user.PrimaryEmail)
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	log.Printf("[INFO] Found %d users", len(users))
+	d.SetId(time.Now().UTC().String())
+	d.Set("emails", emails)
+	d.Set("users", users)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// maxDomainTypoDistance is the edit distance up to which an unknown domain is
+// taken to be a typo of a verified one, e.g. comapny.com for company.com.
+const maxDomainTypoDistance = 2
+
+// verifiedDomains returns the verified domains and domain aliases of the
+// provider's customer. They are only listed once per provider.
+func (c *Config) verifiedDomains(ctx context.Context) (map[string]bool, error) {
+	c.domainsOnce.Do(func() {
+		directorySvc, err := c.directoryService(directory.AdminDirectoryDomainReadonlyScope)
+		if err != nil {
+			c.domainsErr = err
+			return
+		}
+
+		resp, err := directorySvc.Domains.List(c.CustomerID).Context(ctx).Do()
+		if err != nil {
+			c.domainsErr = fmt.Errorf("Error listing domains to validate emails: %s", err)
+			return
+		}
+
+		c.domains = map[string]bool{}
+		for _, domain := range resp.Domains {
+			if domain.Verified {
+				c.domains[strings.ToLower(domain.DomainName)] = true
+			}
+			for _, alias := range domain.DomainAliases {
+				if alias.Verified {
+					c.domains[strings.ToLower(alias.DomainAliasName)] = true
+				}
+			}
+		}
+		log.Printf("[DEBUG] Validating emails against %d verified domains", len(c.domains))
+	})
+	return c.domains, c.domainsErr
+}
+
+// validateEmailDomain returns a CustomizeDiffFunc checking, when the provider
+// has validate_email_domains set, that the email in key belongs to a verified
+// domain of the customer. External emails, which are allowed for group
+// members, are only refused when their domain looks like a typo of a
+// verified one.
+func validateEmailDomain(key string, allowExternal bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(*Config)
+		if !config.ValidateEmailDomains || !d.HasChange(key) {
+			return nil
+		}
+
+		// empty while the email is not known yet
+		email := normalizeEmail(d.Get(key).(string))
+		at := strings.LastIndex(email, "@")
+		if at < 0 {
+			return nil
+		}
+		domain := email[at+1:]
+
+		domains, err := config.verifiedDomains(ctx)
+		if err != nil {
+			return err
+		}
+		if domains[domain] {
+			return nil
+		}
+
+		if closest := closestDomain(domain, domains); closest != "" {
+			return fmt.Errorf("%s: %s is not a verified domain, did you mean %s?", key, domain, closest)
+		}
+		if allowExternal {
+			return nil
+		}
+
+		verified := []string{}
+		for name := range domains {
+			verified = append(verified, name)
+		}
+		sort.Strings(verified)
+		return fmt.Errorf("%s: %s is not a verified domain, use one of %s", key, domain, strings.Join(verified, ", "))
+	}
+}
+
+// closestDomain returns the verified domain within maxDomainTypoDistance of
+// domain, if any.
+func closestDomain(domain string, domains map[string]bool) string {
+	closest := ""
+	closestDistance := maxDomainTypoDistance + 1
+	for name := range domains {
+		if distance := editDistance(domain, name); distance < closestDistance || (distance == closestDistance && name < closest) {
+			closest = name
+			closestDistance = distance
+		}
+	}
+	if closestDistance > maxDomainTypoDistance {
+		return ""
+	}
+	return closest
+}
+
+// editDistance returns the Damerau-Levenshtein distance of a and b, counting
+// swapped letters as a single edit.
+func editDistance(a, b string) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = minInt(rows[i-1][j]+1, minInt(rows[i][j-1]+1, rows[i-1][j-1]+cost))
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = minInt(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)][len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
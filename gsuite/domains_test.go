@@ -0,0 +1,36 @@
+package gsuite
+
+import "testing"
+
+func TestClosestDomain(t *testing.T) {
+	domains := map[string]bool{
+		"company.com":    true,
+		"company.co.uk":  true,
+		"subsidiary.com": true,
+		"company.de":     true,
+		"company.dk":     true,
+	}
+
+	cases := []struct {
+		domain string
+		want   string
+	}{
+		// swapped letters count as one edit
+		{"comapny.com", "company.com"},
+		{"compny.com", "company.com"},
+		{"companyy.com", "company.com"},
+		{"company.co", "company.com"},
+		{"subsidary.com", "subsidiary.com"},
+		// ties go to the first domain in alphabetical order
+		{"company.d", "company.de"},
+		{"gmail.com", ""},
+		{"othercompany.com", ""},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		if got := closestDomain(tc.domain, domains); got != tc.want {
+			t.Errorf("closestDomain(%q) = %q, want %q", tc.domain, got, tc.want)
+		}
+	}
+}
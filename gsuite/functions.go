@@ -0,0 +1,147 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stringFunction is a provider-defined function taking and returning a
+// string.
+type stringFunction struct {
+	summary     string
+	description string
+	parameter   string
+	call        func(string) string
+}
+
+// providerFunctions are the provider-defined functions, which canonicalize
+// values the same way the diffs of resources do.
+var providerFunctions = map[string]stringFunction{
+	"normalize_email": {
+		summary:     "Returns the canonical form of an email",
+		description: "Lower-cases the email and trims surrounding whitespace, as the Admin SDK treats emails case-insensitively and returns them in lower case.",
+		parameter:   "email",
+		call:        normalizeEmail,
+	},
+	"user_key": {
+		summary:     "Returns the canonical form of a user key",
+		description: "Normalizes a user key, which is either a primary email, an alias or a unique ID. Emails are lower-cased and trimmed, IDs only trimmed.",
+		parameter:   "key",
+		call:        normalizeDirectoryKey,
+	},
+	"group_key": {
+		summary:     "Returns the canonical form of a group key",
+		description: "Normalizes a group key, which is either an email, an alias or a unique ID. Emails are lower-cased and trimmed, IDs only trimmed.",
+		parameter:   "key",
+		call:        normalizeDirectoryKey,
+	},
+}
+
+// ProviderServer returns the gRPC server of Provider, which also serves the
+// providerFunctions that helper/schema has no support for.
+func ProviderServer() tfprotov5.ProviderServer {
+	return &functionsServer{ProviderServer: Provider().GRPCProvider()}
+}
+
+// functionsServer adds the providerFunctions to a provider server.
+type functionsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *functionsServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.ProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(providerFunctions))
+	for name := range providerFunctions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resp.Functions = append(resp.Functions, tfprotov5.FunctionMetadata{Name: name})
+	}
+	return resp, nil
+}
+
+func (s *functionsServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Functions = functionDefinitions()
+	return resp, nil
+}
+
+func (s *functionsServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{
+		Functions: functionDefinitions(),
+	}, nil
+}
+
+func (s *functionsServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	f, ok := providerFunctions[req.Name]
+	if !ok {
+		return s.ProviderServer.CallFunction(ctx, req)
+	}
+
+	if len(req.Arguments) != 1 || req.Arguments[0] == nil {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{
+				Text: fmt.Sprintf("%s takes exactly one argument, got %d", req.Name, len(req.Arguments)),
+			},
+		}, nil
+	}
+
+	var arg string
+	v, err := req.Arguments[0].Unmarshal(tftypes.String)
+	if err == nil {
+		err = v.As(&arg)
+	}
+	if err != nil {
+		argument := int64(0)
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{
+				Text:             fmt.Sprintf("Error reading the %s argument: %s", f.parameter, err),
+				FunctionArgument: &argument,
+			},
+		}, nil
+	}
+
+	result, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, f.call(arg)))
+	if err != nil {
+		return &tfprotov5.CallFunctionResponse{
+			Error: &tfprotov5.FunctionError{
+				Text: fmt.Sprintf("Error encoding the result: %s", err),
+			},
+		}, nil
+	}
+	return &tfprotov5.CallFunctionResponse{Result: &result}, nil
+}
+
+// functionDefinitions returns the signatures of the providerFunctions.
+func functionDefinitions() map[string]*tfprotov5.Function {
+	functions := map[string]*tfprotov5.Function{}
+	for name, f := range providerFunctions {
+		functions[name] = &tfprotov5.Function{
+			Summary:         f.summary,
+			Description:     f.description,
+			DescriptionKind: tfprotov5.StringKindPlain,
+			Parameters: []*tfprotov5.FunctionParameter{
+				{
+					Name: f.parameter,
+					Type: tftypes.String,
+				},
+			},
+			Return: &tfprotov5.FunctionReturn{
+				Type: tftypes.String,
+			},
+		}
+	}
+	return functions
+}
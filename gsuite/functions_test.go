@@ -0,0 +1,78 @@
+package gsuite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderServerFunctions(t *testing.T) {
+	server := ProviderServer()
+
+	schema, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata, err := server.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schema.Functions) != len(providerFunctions) || len(metadata.Functions) != len(providerFunctions) {
+		t.Fatalf("got %d functions in the schema and %d in the metadata, want %d", len(schema.Functions), len(metadata.Functions), len(providerFunctions))
+	}
+	if schema.ResourceSchemas["gsuite_user"] == nil {
+		t.Error("the schema is missing resources of the provider")
+	}
+
+	cases := []struct {
+		name    string
+		arg     string
+		want    string
+		wantErr bool
+	}{
+		{name: "normalize_email", arg: " Jane.Doe@Example.COM ", want: "jane.doe@example.com"},
+		{name: "user_key", arg: "Jane@Example.com", want: "jane@example.com"},
+		{name: "user_key", arg: " 1234567890 ", want: "1234567890"},
+		{name: "group_key", arg: "Team@Example.com", want: "team@example.com"},
+		{name: "group_key", arg: "03abc123XYZ", want: "03abc123XYZ"},
+		{name: "unknown", arg: "jane@example.com", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		arg, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, tc.arg))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+			Name:      tc.name,
+			Arguments: []*tfprotov5.DynamicValue{&arg},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tc.wantErr {
+			if resp.Error == nil {
+				t.Errorf("%s(%q) succeeded, want an error", tc.name, tc.arg)
+			}
+			continue
+		}
+		if resp.Error != nil {
+			t.Errorf("%s(%q) failed: %s", tc.name, tc.arg, resp.Error.Text)
+			continue
+		}
+
+		v, err := resp.Result.Unmarshal(tftypes.String)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got string
+		if err := v.As(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("%s(%q) = %q, want %q", tc.name, tc.arg, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	reports "google.golang.org/api/admin/reports/v1"
+)
+
+// activityQuerySchema returns the arguments shared by every Reports API
+// activity data source.
+func activityQuerySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_key": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "all",
+		},
+
+		"event_name": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"filters": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"start_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"end_time": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"actor_ip_address": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"org_unit_id": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"max_results": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		"activities": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"unique_qualifier": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"actor_email": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"actor_profile_id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"actor_caller_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"ip_address": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"owner_domain": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"events": &schema.Schema{
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"type": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"parameters": &schema.Schema{
+									Type:     schema.TypeMap,
+									Computed: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listActivities pages through the Reports API activities of the given
+// application using the query arguments in d. Any extra filters are appended
+// to the user supplied ones.
+func listActivities(ctx context.Context, config *Config, d *schema.ResourceData, applicationName string, extraFilters []string) ([]*reports.Activity, error) {
+	config.checkAPIEnabled("reports")
+
+	filters := extraFilters
+	for _, v := range d.Get("filters").([]interface{}) {
+		filters = append(filters, v.(string))
+	}
+
+	maxResults := d.Get("max_results").(int)
+
+	var activities []*reports.Activity
+	pageToken := ""
+	for {
+		call := config.reports.Activities.List(d.Get("user_key").(string), applicationName)
+		if v, ok := d.GetOk("event_name"); ok {
+			call = call.EventName(v.(string))
+		}
+		if len(filters) > 0 {
+			call = call.Filters(strings.Join(filters, ","))
+		}
+		if v, ok := d.GetOk("start_time"); ok {
+			call = call.StartTime(v.(string))
+		}
+		if v, ok := d.GetOk("end_time"); ok {
+			call = call.EndTime(v.(string))
+		}
+		if v, ok := d.GetOk("actor_ip_address"); ok {
+			call = call.ActorIpAddress(v.(string))
+		}
+		if v, ok := d.GetOk("org_unit_id"); ok {
+			call = call.OrgUnitID(v.(string))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		log.Printf("[DEBUG] Listing %s activities (page token: %q)", applicationName, pageToken)
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing %s activities: %s", applicationName, err)
+		}
+
+		activities = append(activities, resp.Items...)
+		if maxResults > 0 && len(activities) >= maxResults {
+			return activities[:maxResults], nil
+		}
+
+		if resp.NextPageToken == "" {
+			return activities, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func flattenActivities(activities []*reports.Activity) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(activities))
+	for i, activity := range activities {
+		result[i] = map[string]interface{}{
+			"ip_address":   activity.IpAddress,
+			"owner_domain": activity.OwnerDomain,
+			"events":       flattenActivityEvents(activity.Events),
+		}
+		if activity.Id != nil {
+			result[i]["time"] = activity.Id.Time
+			result[i]["unique_qualifier"] = strconv.FormatInt(activity.Id.UniqueQualifier, 10)
+		}
+		if activity.Actor != nil {
+			result[i]["actor_email"] = activity.Actor.Email
+			result[i]["actor_profile_id"] = activity.Actor.ProfileId
+			result[i]["actor_caller_type"] = activity.Actor.CallerType
+		}
+	}
+	return result
+}
+
+func flattenActivityEvents(events []*reports.ActivityEvents) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(events))
+	for i, event := range events {
+		parameters := map[string]interface{}{}
+		for _, parameter := range event.Parameters {
+			parameters[parameter.Name] = flattenActivityEventParameter(parameter)
+		}
+		result[i] = map[string]interface{}{
+			"name":       event.Name,
+			"type":       event.Type,
+			"parameters": parameters,
+		}
+	}
+	return result
+}
+
+// flattenActivityEventParameter renders a parameter value as a string, since
+// the API returns one of several typed fields depending on the parameter.
+func flattenActivityEventParameter(parameter *reports.ActivityEventsParameters) string {
+	switch {
+	case parameter.Value != "":
+		return parameter.Value
+	case len(parameter.MultiValue) > 0:
+		return strings.Join(parameter.MultiValue, ",")
+	case parameter.IntValue != 0:
+		return strconv.FormatInt(parameter.IntValue, 10)
+	case len(parameter.MultiIntValue) > 0:
+		values := make([]string, len(parameter.MultiIntValue))
+		for i, v := range parameter.MultiIntValue {
+			values[i] = strconv.FormatInt(v, 10)
+		}
+		return strings.Join(values, ",")
+	default:
+		return strconv.FormatBool(parameter.BoolValue)
+	}
+}
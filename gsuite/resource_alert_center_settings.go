@@ -0,0 +1,138 @@
+package gsuite
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	alertcenter "google.golang.org/api/alertcenter/v1beta1"
+)
+
+// resourceAlertCenterSettings manages the customer wide Alert Center
+// settings, there is only one per customer. Destroying the resource removes
+// all notifications.
+func resourceAlertCenterSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlertCenterSettingsUpdate,
+		ReadContext:   resourceAlertCenterSettingsRead,
+		UpdateContext: resourceAlertCenterSettingsUpdate,
+		DeleteContext: resourceAlertCenterSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Pub/Sub topics alerts are published to
+			"notification": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// projects/{project}/topics/{topic}, the Alert Center
+						// service account needs to be allowed to publish to it
+						"topic_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"payload_format": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "JSON",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandAlertCenterNotifications(v []interface{}) []*alertcenter.Notification {
+	notifications := []*alertcenter.Notification{}
+	for _, n := range v {
+		notification := n.(map[string]interface{})
+		notifications = append(notifications, &alertcenter.Notification{
+			CloudPubsubTopic: &alertcenter.CloudPubsubTopic{
+				TopicName:     notification["topic_name"].(string),
+				PayloadFormat: notification["payload_format"].(string),
+			},
+		})
+	}
+	return notifications
+}
+
+func flattenAlertCenterNotifications(notifications []*alertcenter.Notification) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, notification := range notifications {
+		if notification.CloudPubsubTopic == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"topic_name":     notification.CloudPubsubTopic.TopicName,
+			"payload_format": notification.CloudPubsubTopic.PayloadFormat,
+		})
+	}
+	return result
+}
+
+func resourceAlertCenterSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	alertCenterSvc, err := config.alertCenterService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings := &alertcenter.Settings{
+		Notifications:   expandAlertCenterNotifications(d.Get("notification").([]interface{})),
+		ForceSendFields: []string{"Notifications"},
+	}
+
+	log.Printf("[DEBUG] Updating alert center settings with %d notifications", len(settings.Notifications))
+	_, err = alertCenterSvc.V1beta1.UpdateSettings(settings).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating alert center settings: %s", err)
+	}
+
+	d.SetId("alert-center-settings")
+	log.Printf("[INFO] Updated alert center settings")
+	return resourceAlertCenterSettingsRead(ctx, d, meta)
+}
+
+func resourceAlertCenterSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	alertCenterSvc, err := config.alertCenterService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings, err := alertCenterSvc.V1beta1.GetSettings().Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, "alert center settings"))
+	}
+
+	d.Set("notification", flattenAlertCenterNotifications(settings.Notifications))
+
+	return nil
+}
+
+func resourceAlertCenterSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	alertCenterSvc, err := config.alertCenterService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = alertCenterSvc.V1beta1.UpdateSettings(&alertcenter.Settings{
+		ForceSendFields: []string{"Notifications"},
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting alert center settings: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,120 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	alertcenter "google.golang.org/api/alertcenter/v1beta1"
+)
+
+// resourceAlertFeedback submits feedback on an alert. Feedback cannot be
+// changed or withdrawn, so destroying the resource only removes it from
+// state.
+func resourceAlertFeedback() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlertFeedbackCreate,
+		ReadContext:   resourceAlertFeedbackRead,
+		DeleteContext: resourceAlertFeedbackDelete,
+
+		Schema: map[string]*schema.Schema{
+			"alert_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// NOT_USEFUL, SOMEWHAT_USEFUL or VERY_USEFUL
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"feedback_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlertFeedbackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	alertCenterSvc, err := config.alertCenterService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alertID := d.Get("alert_id").(string)
+	feedback, err := alertCenterSvc.Alerts.Feedback.Create(alertID, &alertcenter.AlertFeedback{
+		Type: d.Get("type").(string),
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating alert feedback: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", alertID, feedback.FeedbackId))
+	log.Printf("[INFO] Created feedback %s on alert %s", feedback.FeedbackId, alertID)
+	return resourceAlertFeedbackRead(ctx, d, meta)
+}
+
+func resourceAlertFeedbackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	alertID, feedbackID := parts[0], parts[1]
+
+	alertCenterSvc, err := config.alertCenterService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := alertCenterSvc.Alerts.Feedback.List(alertID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("alert feedback %s", d.Id())))
+	}
+
+	var feedback *alertcenter.AlertFeedback
+	for _, f := range resp.Feedback {
+		if f.FeedbackId == feedbackID {
+			feedback = f
+		}
+	}
+
+	if feedback == nil {
+		log.Printf("[WARN] Removing alert feedback %s because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("alert_id", alertID)
+	d.Set("feedback_id", feedback.FeedbackId)
+	d.Set("type", feedback.Type)
+	d.Set("email", feedback.Email)
+	d.Set("create_time", feedback.CreateTime)
+
+	return nil
+}
+
+func resourceAlertFeedbackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Alert feedback cannot be deleted, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,181 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	script "google.golang.org/api/script/v1"
+)
+
+func resourceAppsScriptDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppsScriptDeploymentCreate,
+		ReadContext:   resourceAppsScriptDeploymentRead,
+		UpdateContext: resourceAppsScriptDeploymentUpdate,
+		DeleteContext: resourceAppsScriptDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"script_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the version to deploy, usually the version_number of a
+			// gsuite_apps_script_project with create_version set
+			"version_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"manifest_file_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "appsscript",
+			},
+
+			"deployment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// the URL of the web app entry point, if the manifest declares one
+			"web_app_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"entry_point_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandAppsScriptDeploymentConfig(d *schema.ResourceData) *script.DeploymentConfig {
+	return &script.DeploymentConfig{
+		ScriptId:         d.Get("script_id").(string),
+		VersionNumber:    int64(d.Get("version_number").(int)),
+		Description:      d.Get("description").(string),
+		ManifestFileName: d.Get("manifest_file_name").(string),
+	}
+}
+
+func resourceAppsScriptDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scriptID := d.Get("script_id").(string)
+	deployment, err := scriptSvc.Projects.Deployments.Create(scriptID, expandAppsScriptDeploymentConfig(d)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating apps script deployment: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", scriptID, deployment.DeploymentId))
+	log.Printf("[INFO] Created apps script deployment %s of version %d", deployment.DeploymentId, d.Get("version_number").(int))
+	return resourceAppsScriptDeploymentRead(ctx, d, meta)
+}
+
+func resourceAppsScriptDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("version_number") || d.HasChange("description") || d.HasChange("manifest_file_name") {
+		log.Printf("[DEBUG] Updating apps script deployment to version %d", d.Get("version_number").(int))
+		_, err := scriptSvc.Projects.Deployments.Update(d.Get("script_id").(string), d.Get("deployment_id").(string), &script.UpdateDeploymentRequest{
+			DeploymentConfig: expandAppsScriptDeploymentConfig(d),
+		}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating apps script deployment: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated apps script deployment: %s", d.Id())
+	return resourceAppsScriptDeploymentRead(ctx, d, meta)
+}
+
+func resourceAppsScriptDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	scriptID, deploymentID := parts[0], parts[1]
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployment, err := scriptSvc.Projects.Deployments.Get(scriptID, deploymentID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("apps script deployment %s", d.Id())))
+	}
+
+	d.Set("script_id", scriptID)
+	d.Set("deployment_id", deployment.DeploymentId)
+	d.Set("update_time", deployment.UpdateTime)
+	if deployment.DeploymentConfig != nil {
+		d.Set("version_number", int(deployment.DeploymentConfig.VersionNumber))
+		d.Set("description", deployment.DeploymentConfig.Description)
+		d.Set("manifest_file_name", deployment.DeploymentConfig.ManifestFileName)
+	}
+
+	webAppURL := ""
+	entryPointTypes := []string{}
+	for _, entryPoint := range deployment.EntryPoints {
+		entryPointTypes = append(entryPointTypes, entryPoint.EntryPointType)
+		if entryPoint.WebApp != nil {
+			webAppURL = entryPoint.WebApp.Url
+		}
+	}
+	d.Set("web_app_url", webAppURL)
+	d.Set("entry_point_types", entryPointTypes)
+
+	return nil
+}
+
+func resourceAppsScriptDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = scriptSvc.Projects.Deployments.Delete(d.Get("script_id").(string), d.Get("deployment_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting apps script deployment: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
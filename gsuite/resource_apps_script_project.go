@@ -0,0 +1,285 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drive "google.golang.org/api/drive/v3"
+	script "google.golang.org/api/script/v1"
+)
+
+// resourceAppsScriptProject manages an Apps Script project owned by the
+// provider's impersonated user. The project is a Drive file, so it is trashed
+// through the Drive API on destroy.
+func resourceAppsScriptProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppsScriptProjectCreate,
+		ReadContext:   resourceAppsScriptProjectRead,
+		UpdateContext: resourceAppsScriptProjectUpdate,
+		DeleteContext: resourceAppsScriptProjectDelete,
+
+		CustomizeDiff: resourceAppsScriptProjectCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the Drive file the script is bound to, e.g. a spreadsheet
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// the full content of the project, which has to include the
+			// appsscript JSON manifest
+			"file": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// without extension, e.g. Code or appsscript
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// SERVER_JS, HTML or JSON
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"source": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			// create an immutable version every time the content is pushed,
+			// deployments can then follow version_number
+			"create_version": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"version_description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"version_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"script_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceAppsScriptProjectCustomizeDiff marks version_number as unknown when
+// new content will be versioned, so deployments follow it in the same plan.
+func resourceAppsScriptProjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.Get("create_version").(bool) && d.HasChange("file") {
+		return d.SetNewComputed("version_number")
+	}
+	return nil
+}
+
+func expandAppsScriptFiles(d *schema.ResourceData) []*script.File {
+	files := []*script.File{}
+	for i := range d.Get("file").([]interface{}) {
+		prefix := fmt.Sprintf("file.%d", i)
+		files = append(files, &script.File{
+			Name:   d.Get(prefix + ".name").(string),
+			Type:   d.Get(prefix + ".type").(string),
+			Source: d.Get(prefix + ".source").(string),
+		})
+	}
+	return files
+}
+
+func flattenAppsScriptFiles(files []*script.File) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, file := range files {
+		result = append(result, map[string]interface{}{
+			"name":   file.Name,
+			"type":   file.Type,
+			"source": file.Source,
+		})
+	}
+	return result
+}
+
+// pushAppsScriptContent replaces the content of the project and, when
+// configured, cuts a new version from it.
+func pushAppsScriptContent(ctx context.Context, d *schema.ResourceData, scriptSvc *script.Service) error {
+	scriptID := d.Id()
+
+	_, err := scriptSvc.Projects.UpdateContent(scriptID, &script.Content{
+		Files: expandAppsScriptFiles(d),
+	}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("Error updating apps script project content: %s", err)
+	}
+
+	if !d.Get("create_version").(bool) {
+		return nil
+	}
+
+	version, err := scriptSvc.Projects.Versions.Create(scriptID, &script.Version{
+		Description: d.Get("version_description").(string),
+	}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating apps script project version: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created apps script project %s version %d", scriptID, version.VersionNumber)
+	d.Set("version_number", int(version.VersionNumber))
+	return nil
+}
+
+func resourceAppsScriptProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := scriptSvc.Projects.Create(&script.CreateProjectRequest{
+		Title:    d.Get("title").(string),
+		ParentId: d.Get("parent_id").(string),
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating apps script project: %s", err)
+	}
+
+	d.SetId(project.ScriptId)
+	log.Printf("[INFO] Created apps script project: %s", project.Title)
+
+	if err := pushAppsScriptContent(ctx, d, scriptSvc); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAppsScriptProjectRead(ctx, d, meta)
+}
+
+func resourceAppsScriptProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("file") {
+		log.Printf("[DEBUG] Pushing apps script project content: %s", d.Id())
+		if err := pushAppsScriptContent(ctx, d, scriptSvc); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Updated apps script project: %s", d.Id())
+	return resourceAppsScriptProjectRead(ctx, d, meta)
+}
+
+func resourceAppsScriptProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	scriptSvc, err := config.scriptService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project, err := scriptSvc.Projects.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("apps script project %s", d.Id())))
+	}
+
+	content, err := scriptSvc.Projects.GetContent(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading apps script project content: %s", err)
+	}
+
+	d.Set("script_id", project.ScriptId)
+	d.Set("title", project.Title)
+	d.Set("parent_id", project.ParentId)
+	d.Set("create_time", project.CreateTime)
+	d.Set("update_time", project.UpdateTime)
+	d.Set("file", flattenAppsScriptFiles(content.Files))
+
+	// Pick up the latest version on import
+	if _, ok := d.GetOk("version_number"); !ok {
+		latest := int64(0)
+		pageToken := ""
+		for {
+			call := scriptSvc.Projects.Versions.List(d.Id())
+			if pageToken != "" {
+				call = call.PageToken(pageToken)
+			}
+
+			resp, err := call.Context(ctx).Do()
+			if err != nil {
+				return diag.Errorf("Error listing apps script project versions: %s", err)
+			}
+
+			for _, version := range resp.Versions {
+				if version.VersionNumber > latest {
+					latest = version.VersionNumber
+				}
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+		d.Set("version_number", int(latest))
+	}
+
+	return nil
+}
+
+func resourceAppsScriptProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService("")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = driveSvc.Files.Update(d.Id(), &drive.File{Trashed: true}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error trashing apps script project: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
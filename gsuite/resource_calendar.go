@@ -0,0 +1,185 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func resourceCalendar() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCalendarCreate,
+		ReadContext:   resourceCalendarRead,
+		UpdateContext: resourceCalendarUpdate,
+		DeleteContext: resourceCalendarDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the user the calendar is created as, who becomes its owner
+			"owner_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"calendar_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"summary": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"location": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// IANA time zone name, e.g. Europe/Amsterdam
+			"time_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCalendarCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ownerEmail := d.Get("owner_email").(string)
+	calendarSvc, err := config.calendarService(ownerEmail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cal := &calendar.Calendar{
+		Summary: d.Get("summary").(string),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		log.Printf("[DEBUG] Setting calendar description: %s", v.(string))
+		cal.Description = v.(string)
+	}
+	if v, ok := d.GetOk("location"); ok {
+		log.Printf("[DEBUG] Setting calendar location: %s", v.(string))
+		cal.Location = v.(string)
+	}
+	if v, ok := d.GetOk("time_zone"); ok {
+		log.Printf("[DEBUG] Setting calendar time_zone: %s", v.(string))
+		cal.TimeZone = v.(string)
+	}
+
+	createdCalendar, err := calendarSvc.Calendars.Insert(cal).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating calendar: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", ownerEmail, createdCalendar.Id))
+	log.Printf("[INFO] Created calendar: %s", createdCalendar.Id)
+	return resourceCalendarRead(ctx, d, meta)
+}
+
+func resourceCalendarUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := config.calendarService(d.Get("owner_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cal := &calendar.Calendar{}
+	forceSendFields := []string{}
+
+	if d.HasChange("summary") {
+		log.Printf("[DEBUG] Updating calendar summary: %s", d.Get("summary").(string))
+		cal.Summary = d.Get("summary").(string)
+	}
+	if d.HasChange("description") {
+		log.Printf("[DEBUG] Updating calendar description: %s", d.Get("description").(string))
+		cal.Description = d.Get("description").(string)
+		forceSendFields = append(forceSendFields, "Description")
+	}
+	if d.HasChange("location") {
+		log.Printf("[DEBUG] Updating calendar location: %s", d.Get("location").(string))
+		cal.Location = d.Get("location").(string)
+		forceSendFields = append(forceSendFields, "Location")
+	}
+	if d.HasChange("time_zone") {
+		log.Printf("[DEBUG] Updating calendar time_zone: %s", d.Get("time_zone").(string))
+		cal.TimeZone = d.Get("time_zone").(string)
+	}
+
+	if len(forceSendFields) > 0 {
+		cal.ForceSendFields = forceSendFields
+	}
+
+	updatedCalendar, err := calendarSvc.Calendars.Patch(d.Get("calendar_id").(string), cal).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating calendar: %s", err)
+	}
+
+	log.Printf("[INFO] Updated calendar: %s", updatedCalendar.Id)
+	return resourceCalendarRead(ctx, d, meta)
+}
+
+func resourceCalendarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ownerEmail, calendarID := parts[0], parts[1]
+
+	calendarSvc, err := config.calendarService(ownerEmail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cal, err := calendarSvc.Calendars.Get(calendarID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("calendar %s", calendarID)))
+	}
+
+	d.Set("owner_email", ownerEmail)
+	d.Set("calendar_id", cal.Id)
+	d.Set("summary", cal.Summary)
+	d.Set("description", cal.Description)
+	d.Set("location", cal.Location)
+	d.Set("time_zone", cal.TimeZone)
+
+	return nil
+}
+
+func resourceCalendarDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := config.calendarService(d.Get("owner_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = calendarSvc.Calendars.Delete(d.Get("calendar_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting calendar: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
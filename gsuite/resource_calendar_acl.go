@@ -0,0 +1,228 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func resourceCalendarAcl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCalendarAclCreate,
+		ReadContext:   resourceCalendarAclRead,
+		UpdateContext: resourceCalendarAclUpdate,
+		DeleteContext: resourceCalendarAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"calendar_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"resource_id"},
+			},
+
+			// the ID of a resource calendar (room) in the directory, its
+			// calendar address is looked up instead of passing calendar_id
+			"resource_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"calendar_id"},
+			},
+
+			// the user managing the ACL, defaults to impersonated_user_email
+			// of the provider
+			"owner_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// none, freeBusyReader, reader, writer or owner
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// default, user, group or domain
+			"scope_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// email address or domain name, omitted for the default scope
+			"scope_value": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"send_notifications": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"rule_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// calendarOwnerService creates a calendar service acting as owner_email, or
+// the provider's impersonated user when it is not set.
+func calendarOwnerService(d *schema.ResourceData, config *Config) (*calendar.Service, error) {
+	ownerEmail := d.Get("owner_email").(string)
+	if ownerEmail == "" {
+		ownerEmail = config.ImpersonatedUserEmail
+	}
+	return config.calendarService(ownerEmail)
+}
+
+// calendarAclCalendarID returns the calendar the ACL applies to, resolving
+// resource calendars to their generated email address.
+func calendarAclCalendarID(ctx context.Context, d *schema.ResourceData, config *Config) (string, error) {
+	v, ok := d.GetOk("resource_id")
+	if !ok {
+		calendarID := d.Get("calendar_id").(string)
+		if calendarID == "" {
+			return "", fmt.Errorf("one of calendar_id or resource_id must be set")
+		}
+		return calendarID, nil
+	}
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := directorySvc.Resources.Calendars.Get(customerID(d, config), v.(string)).Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("Error reading calendar resource %s: %s", v.(string), err)
+	}
+
+	log.Printf("[DEBUG] Calendar resource %s uses calendar %s", v.(string), resource.ResourceEmail)
+	return resource.ResourceEmail, nil
+}
+
+func resourceCalendarAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	calendarID, err := calendarAclCalendarID(ctx, d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := &calendar.AclRule{
+		Role: d.Get("role").(string),
+		Scope: &calendar.AclRuleScope{
+			Type:  d.Get("scope_type").(string),
+			Value: d.Get("scope_value").(string),
+		},
+	}
+
+	createdRule, err := calendarSvc.Acl.Insert(calendarID, rule).SendNotifications(d.Get("send_notifications").(bool)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating calendar ACL: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", calendarID, createdRule.Id))
+	log.Printf("[INFO] Created calendar ACL %s on %s", createdRule.Id, calendarID)
+	return resourceCalendarAclRead(ctx, d, meta)
+}
+
+func resourceCalendarAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("role") {
+		rule := &calendar.AclRule{
+			Role: d.Get("role").(string),
+		}
+
+		log.Printf("[DEBUG] Updating calendar ACL role: %s", rule.Role)
+		_, err := calendarSvc.Acl.Patch(d.Get("calendar_id").(string), d.Get("rule_id").(string), rule).SendNotifications(d.Get("send_notifications").(bool)).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating calendar ACL: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated calendar ACL: %s", d.Id())
+	return resourceCalendarAclRead(ctx, d, meta)
+}
+
+func resourceCalendarAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	calendarID, ruleID := parts[0], parts[1]
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := calendarSvc.Acl.Get(calendarID, ruleID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("calendar ACL %s", d.Id())))
+	}
+
+	d.Set("calendar_id", calendarID)
+	d.Set("rule_id", rule.Id)
+	d.Set("role", rule.Role)
+	if rule.Scope != nil {
+		d.Set("scope_type", rule.Scope.Type)
+		d.Set("scope_value", rule.Scope.Value)
+	}
+
+	return nil
+}
+
+func resourceCalendarAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = calendarSvc.Acl.Delete(d.Get("calendar_id").(string), d.Get("rule_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting calendar ACL: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,328 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func resourceCalendarEvent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCalendarEventCreate,
+		ReadContext:   resourceCalendarEventRead,
+		UpdateContext: resourceCalendarEventUpdate,
+		DeleteContext: resourceCalendarEventDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"calendar_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the user managing the event, defaults to impersonated_user_email
+			// of the provider
+			"owner_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"event_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"summary": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"location": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// RFC3339 timestamps, e.g. 2018-03-01T10:00:00+01:00
+			"start_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"end_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			// required for recurring events, e.g. Europe/Amsterdam
+			"time_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// RRULE, EXRULE, RDATE and EXDATE lines as defined in RFC5545,
+			// e.g. "RRULE:FREQ=WEEKLY;BYDAY=MO"
+			"recurrence": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// user or group email addresses
+			"attendees": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"guests_can_modify": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"guests_can_invite_others": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"guests_can_see_other_guests": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// default, public, private or confidential
+			"visibility": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "default",
+			},
+
+			// all, externalOnly or none
+			"send_updates": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "none",
+			},
+
+			// adds a Google Meet conference to the event
+			"create_conference": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"conference_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hangout_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"html_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandCalendarEvent(d *schema.ResourceData) *calendar.Event {
+	timeZone := d.Get("time_zone").(string)
+
+	event := &calendar.Event{
+		Summary:                 d.Get("summary").(string),
+		Description:             d.Get("description").(string),
+		Location:                d.Get("location").(string),
+		Recurrence:              convertStringList(d.Get("recurrence").([]interface{})),
+		GuestsCanModify:         d.Get("guests_can_modify").(bool),
+		GuestsCanInviteOthers:   boolPtr(d.Get("guests_can_invite_others").(bool)),
+		GuestsCanSeeOtherGuests: boolPtr(d.Get("guests_can_see_other_guests").(bool)),
+		Visibility:              d.Get("visibility").(string),
+		Start: &calendar.EventDateTime{
+			DateTime: d.Get("start_time").(string),
+			TimeZone: timeZone,
+		},
+		End: &calendar.EventDateTime{
+			DateTime: d.Get("end_time").(string),
+			TimeZone: timeZone,
+		},
+		ForceSendFields: []string{"Description", "Location", "Recurrence", "GuestsCanModify"},
+	}
+
+	attendees := []*calendar.EventAttendee{}
+	for _, v := range d.Get("attendees").(*schema.Set).List() {
+		attendees = append(attendees, &calendar.EventAttendee{Email: v.(string)})
+	}
+	event.Attendees = attendees
+
+	return event
+}
+
+func resourceCalendarEventCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	calendarID := d.Get("calendar_id").(string)
+	event := expandCalendarEvent(d)
+
+	if d.Get("create_conference").(bool) {
+		log.Printf("[DEBUG] Requesting a conference for event %s", event.Summary)
+		event.ConferenceData = &calendar.ConferenceData{
+			CreateRequest: &calendar.CreateConferenceRequest{
+				RequestId: id.UniqueId(),
+				ConferenceSolutionKey: &calendar.ConferenceSolutionKey{
+					Type: "hangoutsMeet",
+				},
+			},
+		}
+	}
+
+	createdEvent, err := calendarSvc.Events.Insert(calendarID, event).
+		ConferenceDataVersion(1).
+		SendUpdates(d.Get("send_updates").(string)).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating calendar event: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", calendarID, createdEvent.Id))
+	log.Printf("[INFO] Created calendar event %s on %s", createdEvent.Id, calendarID)
+	return resourceCalendarEventRead(ctx, d, meta)
+}
+
+func resourceCalendarEventUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedEvent, err := calendarSvc.Events.Patch(d.Get("calendar_id").(string), d.Get("event_id").(string), expandCalendarEvent(d)).
+		ConferenceDataVersion(1).
+		SendUpdates(d.Get("send_updates").(string)).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating calendar event: %s", err)
+	}
+
+	log.Printf("[INFO] Updated calendar event: %s", updatedEvent.Id)
+	return resourceCalendarEventRead(ctx, d, meta)
+}
+
+func resourceCalendarEventRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	calendarID, eventID := parts[0], parts[1]
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	event, err := calendarSvc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("calendar event %s", d.Id())))
+	}
+
+	if event.Status == "cancelled" {
+		log.Printf("[WARN] Removing calendar event %s because it was cancelled", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("calendar_id", calendarID)
+	d.Set("event_id", event.Id)
+	d.Set("summary", event.Summary)
+	d.Set("description", event.Description)
+	d.Set("location", event.Location)
+	d.Set("recurrence", event.Recurrence)
+	d.Set("guests_can_modify", event.GuestsCanModify)
+	d.Set("visibility", event.Visibility)
+	d.Set("hangout_link", event.HangoutLink)
+	d.Set("html_link", event.HtmlLink)
+
+	if event.GuestsCanInviteOthers != nil {
+		d.Set("guests_can_invite_others", *event.GuestsCanInviteOthers)
+	}
+	if event.GuestsCanSeeOtherGuests != nil {
+		d.Set("guests_can_see_other_guests", *event.GuestsCanSeeOtherGuests)
+	}
+	if event.Start != nil {
+		d.Set("start_time", event.Start.DateTime)
+		if event.Start.TimeZone != "" {
+			d.Set("time_zone", event.Start.TimeZone)
+		}
+	}
+	if event.End != nil {
+		d.Set("end_time", event.End.DateTime)
+	}
+	if event.ConferenceData != nil {
+		d.Set("conference_id", event.ConferenceData.ConferenceId)
+	}
+
+	attendees := make([]string, 0, len(event.Attendees))
+	for _, attendee := range event.Attendees {
+		// The organizer shows up as an attendee once anyone is invited
+		if attendee.Organizer {
+			continue
+		}
+		attendees = append(attendees, attendee.Email)
+	}
+	d.Set("attendees", attendees)
+
+	return nil
+}
+
+func resourceCalendarEventDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	calendarSvc, err := calendarOwnerService(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = calendarSvc.Events.Delete(d.Get("calendar_id").(string), d.Get("event_id").(string)).
+		SendUpdates(d.Get("send_updates").(string)).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting calendar event: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
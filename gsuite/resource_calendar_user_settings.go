@@ -0,0 +1,214 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	calendar "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// resourceCalendarUserSettings manages the calendar defaults of a user. The
+// Calendar API exposes user settings (such as working hours) read-only, so
+// only the primary calendar time zone and the calendar list entry can be
+// changed; the remaining settings are exported for drift detection.
+func resourceCalendarUserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCalendarUserSettingsCreate,
+		ReadContext:   resourceCalendarUserSettingsRead,
+		UpdateContext: resourceCalendarUserSettingsUpdate,
+		DeleteContext: resourceCalendarUserSettingsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the calendar list entry to configure
+			"calendar_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "primary",
+				ForceNew: true,
+			},
+
+			// time zone of the user's primary calendar, e.g. Europe/Amsterdam
+			"time_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_reminders": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// email or popup
+						"method": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"minutes": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"color_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"summary_override": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hidden": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"selected": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"settings": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCalendarUserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("user_email").(string), d.Get("calendar_id").(string)))
+	return resourceCalendarUserSettingsUpdate(ctx, d, meta)
+}
+
+func resourceCalendarUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userEmail := d.Get("user_email").(string)
+	calendarID := d.Get("calendar_id").(string)
+	calendarSvc, err := config.calendarService(userEmail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("time_zone"); ok && d.HasChange("time_zone") {
+		log.Printf("[DEBUG] Updating primary calendar time_zone for %s: %s", userEmail, v.(string))
+		_, err := calendarSvc.Calendars.Patch("primary", &calendar.Calendar{TimeZone: v.(string)}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating time zone for %s: %s", userEmail, err)
+		}
+	}
+
+	// Calendars that are not in the user's list yet have to be added first
+	if _, err := calendarSvc.CalendarList.Get(calendarID).Context(ctx).Do(); err != nil {
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			log.Printf("[DEBUG] Adding calendar %s to the calendar list of %s", calendarID, userEmail)
+			_, err = calendarSvc.CalendarList.Insert(&calendar.CalendarListEntry{Id: calendarID}).Context(ctx).Do()
+		}
+		if err != nil {
+			return diag.Errorf("Error reading calendar list entry %s: %s", calendarID, err)
+		}
+	}
+
+	reminders := []*calendar.EventReminder{}
+	for i := 0; i < d.Get("default_reminders.#").(int); i++ {
+		prefix := fmt.Sprintf("default_reminders.%d", i)
+		reminders = append(reminders, &calendar.EventReminder{
+			Method:  d.Get(prefix + ".method").(string),
+			Minutes: int64(d.Get(prefix + ".minutes").(int)),
+		})
+	}
+
+	entry := &calendar.CalendarListEntry{
+		DefaultReminders: reminders,
+		SummaryOverride:  d.Get("summary_override").(string),
+		Hidden:           d.Get("hidden").(bool),
+		Selected:         d.Get("selected").(bool),
+		ForceSendFields:  []string{"DefaultReminders", "SummaryOverride", "Hidden", "Selected"},
+	}
+	if v, ok := d.GetOk("color_id"); ok {
+		entry.ColorId = v.(string)
+	}
+
+	_, err = calendarSvc.CalendarList.Patch(calendarID, entry).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating calendar list entry %s: %s", calendarID, err)
+	}
+
+	log.Printf("[INFO] Updated calendar settings for %s", userEmail)
+	return resourceCalendarUserSettingsRead(ctx, d, meta)
+}
+
+func resourceCalendarUserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userEmail := d.Get("user_email").(string)
+	calendarSvc, err := config.calendarService(userEmail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entry, err := calendarSvc.CalendarList.Get(d.Get("calendar_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("calendar settings %s", d.Id())))
+	}
+
+	primary, err := calendarSvc.Calendars.Get("primary").Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading primary calendar of %s: %s", userEmail, err)
+	}
+
+	settings, err := calendarSvc.Settings.List().Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading calendar settings of %s: %s", userEmail, err)
+	}
+
+	settingsMap := map[string]string{}
+	for _, setting := range settings.Items {
+		settingsMap[setting.Id] = setting.Value
+	}
+
+	reminders := make([]map[string]interface{}, len(entry.DefaultReminders))
+	for i, reminder := range entry.DefaultReminders {
+		reminders[i] = map[string]interface{}{
+			"method":  reminder.Method,
+			"minutes": reminder.Minutes,
+		}
+	}
+
+	d.Set("time_zone", primary.TimeZone)
+	d.Set("default_reminders", reminders)
+	d.Set("color_id", entry.ColorId)
+	d.Set("summary_override", entry.SummaryOverride)
+	d.Set("hidden", entry.Hidden)
+	d.Set("selected", entry.Selected)
+	d.Set("settings", settingsMap)
+
+	return nil
+}
+
+// resourceCalendarUserSettingsDelete only removes the settings from state,
+// the user keeps whatever was last applied.
+func resourceCalendarUserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Calendar settings %s are left as they are", d.Id())
+	d.SetId("")
+	return nil
+}
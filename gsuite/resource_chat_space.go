@@ -0,0 +1,190 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	chat "google.golang.org/api/chat/v1"
+)
+
+func resourceChatSpace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChatSpaceCreate,
+		ReadContext:   resourceChatSpaceRead,
+		UpdateContext: resourceChatSpaceUpdate,
+		DeleteContext: resourceChatSpaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// SPACE or GROUP_CHAT
+			"space_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "SPACE",
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"guidelines": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// HISTORY_ON or HISTORY_OFF
+			"history_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// allow users outside the domain, cannot be turned off again
+			"external_user_allowed": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"space_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandChatSpaceDetails(d *schema.ResourceData) *chat.SpaceDetails {
+	return &chat.SpaceDetails{
+		Description:     d.Get("description").(string),
+		Guidelines:      d.Get("guidelines").(string),
+		ForceSendFields: []string{"Description", "Guidelines"},
+	}
+}
+
+func resourceChatSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	space := &chat.Space{
+		DisplayName:         d.Get("display_name").(string),
+		SpaceType:           d.Get("space_type").(string),
+		SpaceDetails:        expandChatSpaceDetails(d),
+		SpaceHistoryState:   d.Get("history_state").(string),
+		ExternalUserAllowed: d.Get("external_user_allowed").(bool),
+	}
+
+	createdSpace, err := chatSvc.Spaces.Create(space).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating chat space: %s", err)
+	}
+
+	d.SetId(createdSpace.Name)
+	log.Printf("[INFO] Created chat space: %s", createdSpace.DisplayName)
+	return resourceChatSpaceRead(ctx, d, meta)
+}
+
+func resourceChatSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	space := &chat.Space{}
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		log.Printf("[DEBUG] Updating chat space display_name: %s", d.Get("display_name").(string))
+		space.DisplayName = d.Get("display_name").(string)
+		updateMask = append(updateMask, "displayName")
+	}
+	if d.HasChange("description") || d.HasChange("guidelines") {
+		log.Printf("[DEBUG] Updating chat space details")
+		space.SpaceDetails = expandChatSpaceDetails(d)
+		updateMask = append(updateMask, "spaceDetails")
+	}
+	if d.HasChange("history_state") {
+		log.Printf("[DEBUG] Updating chat space history_state: %s", d.Get("history_state").(string))
+		space.SpaceHistoryState = d.Get("history_state").(string)
+		updateMask = append(updateMask, "spaceHistoryState")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := chatSvc.Spaces.Patch(d.Id(), space).UpdateMask(strings.Join(updateMask, ",")).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating chat space: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated chat space: %s", d.Id())
+	return resourceChatSpaceRead(ctx, d, meta)
+}
+
+func resourceChatSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	space, err := chatSvc.Spaces.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("chat space %s", d.Id())))
+	}
+
+	d.Set("display_name", space.DisplayName)
+	d.Set("space_type", space.SpaceType)
+	d.Set("history_state", space.SpaceHistoryState)
+	d.Set("external_user_allowed", space.ExternalUserAllowed)
+	d.Set("space_uri", space.SpaceUri)
+	d.Set("create_time", space.CreateTime)
+	if space.SpaceDetails != nil {
+		d.Set("description", space.SpaceDetails.Description)
+		d.Set("guidelines", space.SpaceDetails.Guidelines)
+	}
+
+	return nil
+}
+
+func resourceChatSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = chatSvc.Spaces.Delete(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting chat space: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,169 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	chat "google.golang.org/api/chat/v1"
+)
+
+func resourceChatSpaceMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChatSpaceMemberCreate,
+		ReadContext:   resourceChatSpaceMemberRead,
+		UpdateContext: resourceChatSpaceMemberUpdate,
+		DeleteContext: resourceChatSpaceMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the name of the space, e.g. spaces/AAAAxxxx
+			"space": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// set either user_email or group_email
+			"user_email": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group_email"},
+			},
+
+			"group_email": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_email"},
+			},
+
+			// ROLE_MEMBER or ROLE_MANAGER, only users can be managers
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ROLE_MEMBER",
+			},
+
+			// users/{id} or groups/{id}
+			"member_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceChatSpaceMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	membership := &chat.Membership{
+		Role: d.Get("role").(string),
+	}
+
+	if v, ok := d.GetOk("group_email"); ok {
+		// Chat only accepts the directory ID of groups
+		group, err := config.directory.Groups.Get(v.(string)).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error looking up group %s: %s", v.(string), err)
+		}
+		membership.GroupMember = &chat.Group{Name: "groups/" + group.Id}
+	} else if v, ok := d.GetOk("user_email"); ok {
+		membership.Member = &chat.User{Name: "users/" + v.(string), Type: "HUMAN"}
+	} else {
+		return diag.Errorf("Error creating chat space member: one of user_email or group_email is required")
+	}
+
+	space := d.Get("space").(string)
+	createdMembership, err := chatSvc.Spaces.Members.Create(space, membership).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating chat space member: %s", err)
+	}
+
+	d.SetId(createdMembership.Name)
+	log.Printf("[INFO] Created chat space member: %s", createdMembership.Name)
+	return resourceChatSpaceMemberRead(ctx, d, meta)
+}
+
+func resourceChatSpaceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("role") {
+		log.Printf("[DEBUG] Updating chat space member role: %s", d.Get("role").(string))
+		_, err := chatSvc.Spaces.Members.Patch(d.Id(), &chat.Membership{
+			Role: d.Get("role").(string),
+		}).UpdateMask("role").Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating chat space member: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated chat space member: %s", d.Id())
+	return resourceChatSpaceMemberRead(ctx, d, meta)
+}
+
+func resourceChatSpaceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	membership, err := chatSvc.Spaces.Members.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("chat space member %s", d.Id())))
+	}
+
+	// Members who left the space are still returned
+	if membership.State == "NOT_A_MEMBER" {
+		log.Printf("[WARN] Removing chat space member %s because it left the space", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("space", strings.SplitN(d.Id(), "/members/", 2)[0])
+	d.Set("role", membership.Role)
+	if membership.Member != nil {
+		d.Set("member_name", membership.Member.Name)
+	}
+	if membership.GroupMember != nil {
+		d.Set("member_name", membership.GroupMember.Name)
+	}
+
+	return nil
+}
+
+func resourceChatSpaceMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chatSvc, err := config.chatService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = chatSvc.Spaces.Members.Delete(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting chat space member: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,206 @@
+package gsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+)
+
+// chromeEnrollmentTokensURL returns the Chrome Browser Cloud Management
+// endpoint for enrollment tokens, not covered by the generated Go clients.
+func chromeEnrollmentTokensURL(d *schema.ResourceData, config *Config) string {
+	return fmt.Sprintf("%sadmin/directory/v1.1beta1/customer/%s/chrome/enrollmentTokens", config.endpoint("chrome_browser", "https://www.googleapis.com/"), customerID(d, config))
+}
+
+type chromeEnrollmentToken struct {
+	Token            string `json:"token"`
+	TokenPermanentID string `json:"tokenPermanentId"`
+	OrgUnitPath      string `json:"orgUnitPath"`
+	CreateTime       string `json:"createTime"`
+	ExpireTime       string `json:"expireTime"`
+	State            string `json:"state"`
+}
+
+type chromeEnrollmentTokenList struct {
+	ChromeEnrollmentTokens []*chromeEnrollmentToken `json:"chromeEnrollmentTokens"`
+	NextPageToken          string                   `json:"nextPageToken"`
+}
+
+// resourceChromeBrowserEnrollmentToken creates a token to enroll browsers
+// into an org unit. Tokens cannot be changed, and destroying the resource
+// revokes the token.
+func resourceChromeBrowserEnrollmentToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChromeBrowserEnrollmentTokenCreate,
+		ReadContext:   resourceChromeBrowserEnrollmentTokenRead,
+		DeleteContext: resourceChromeBrowserEnrollmentTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"org_unit_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+				ForceNew: true,
+			},
+
+			// the token never expires when not set
+			"expire_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"token": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			// ACTIVE, REVOKED or EXPIRED
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// doChromeBrowserRequest sends a JSON request and decodes the JSON response
+// into result, API errors are returned as *googleapi.Error.
+func doChromeBrowserRequest(ctx context.Context, config *Config, method, url string, body interface{}, result interface{}) error {
+	client, err := config.chromeBrowserClient()
+	if err != nil {
+		return err
+	}
+
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, &reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", config.userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func resourceChromeBrowserEnrollmentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	body := map[string]string{
+		"token_type":    "CHROME_BROWSER",
+		"org_unit_path": d.Get("org_unit_path").(string),
+	}
+	if v, ok := d.GetOk("expire_time"); ok {
+		log.Printf("[DEBUG] Setting enrollment token expire_time: %s", v.(string))
+		body["expire_time"] = v.(string)
+	}
+
+	token := &chromeEnrollmentToken{}
+	if err := doChromeBrowserRequest(ctx, config, "POST", chromeEnrollmentTokensURL(d, config), body, token); err != nil {
+		return diag.Errorf("Error creating chrome browser enrollment token: %s", err)
+	}
+
+	d.SetId(token.TokenPermanentID)
+	d.Set("token", token.Token)
+	log.Printf("[INFO] Created chrome browser enrollment token for %s", token.OrgUnitPath)
+	return resourceChromeBrowserEnrollmentTokenRead(ctx, d, meta)
+}
+
+func resourceChromeBrowserEnrollmentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	// There is no call to get a single token, so page through the tokens
+	var token *chromeEnrollmentToken
+	pageToken := ""
+	for token == nil {
+		params := url.Values{}
+		if pageToken != "" {
+			params.Set("pageToken", pageToken)
+		}
+
+		resp := &chromeEnrollmentTokenList{}
+		if err := doChromeBrowserRequest(ctx, config, "GET", chromeEnrollmentTokensURL(d, config)+"?"+params.Encode(), nil, resp); err != nil {
+			return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("chrome browser enrollment token %s", d.Id())))
+		}
+
+		for _, t := range resp.ChromeEnrollmentTokens {
+			if t.TokenPermanentID == d.Id() {
+				token = t
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if token == nil || token.State == "REVOKED" {
+		log.Printf("[WARN] Removing chrome browser enrollment token %s because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("org_unit_path", token.OrgUnitPath)
+	d.Set("expire_time", token.ExpireTime)
+	d.Set("state", token.State)
+	d.Set("create_time", token.CreateTime)
+	if token.Token != "" {
+		d.Set("token", token.Token)
+	}
+
+	return nil
+}
+
+func resourceChromeBrowserEnrollmentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	revokeURL := fmt.Sprintf("%s/%s:revoke", chromeEnrollmentTokensURL(d, config), d.Id())
+	if err := doChromeBrowserRequest(ctx, config, "POST", revokeURL, nil, nil); err != nil {
+		return diag.Errorf("Error revoking chrome browser enrollment token: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,385 @@
+package gsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// chromePolicyCustomer returns the customer the Chrome Policy API calls act on.
+func chromePolicyCustomer(d *schema.ResourceData, config *Config) string {
+	return "customers/" + customerID(d, config)
+}
+
+func resourceChromePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChromePolicyCreate,
+		ReadContext:   resourceChromePolicyRead,
+		UpdateContext: resourceChromePolicyUpdate,
+		DeleteContext: resourceChromePolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"org_unit_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group_id"},
+			},
+
+			// only some policies, mostly app policies, can target groups
+			"group_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"org_unit_id"},
+			},
+
+			// extra keys identifying the target, e.g. app_id for app policies
+			"additional_target_keys": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"policies": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// e.g. chrome.users.MaxConnectionsPerProxy
+						"schema_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// field names mapped to their JSON encoded values
+						"schema_values": &schema.Schema{
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// chromePolicyTargetKey returns the target the policies are applied to.
+func chromePolicyTargetKey(d *schema.ResourceData) *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey {
+	targetKey := &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{
+		TargetResource: "orgunits/" + strings.TrimPrefix(d.Get("org_unit_id").(string), "id:"),
+	}
+	if v, ok := d.GetOk("group_id"); ok {
+		targetKey.TargetResource = "groups/" + v.(string)
+	}
+
+	if v, ok := d.GetOk("additional_target_keys"); ok {
+		targetKey.AdditionalTargetKeys = map[string]string{}
+		for k, value := range v.(map[string]interface{}) {
+			targetKey.AdditionalTargetKeys[k] = value.(string)
+		}
+	}
+
+	return targetKey
+}
+
+// expandChromePolicyValue decodes the JSON encoded field values into the
+// value of a policy, and returns the names of the fields set.
+func expandChromePolicyValue(policy map[string]interface{}) (*chromepolicy.GoogleChromePolicyVersionsV1PolicyValue, []string, error) {
+	schemaName := policy["schema_name"].(string)
+
+	values := map[string]interface{}{}
+	fields := []string{}
+	for field, v := range policy["schema_values"].(map[string]interface{}) {
+		var value interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &value); err != nil {
+			return nil, nil, fmt.Errorf("Error decoding value of %s.%s: %s", schemaName, field, err)
+		}
+		values[field] = value
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &chromepolicy.GoogleChromePolicyVersionsV1PolicyValue{
+		PolicySchema: schemaName,
+		Value:        googleapi.RawMessage(raw),
+	}, fields, nil
+}
+
+func modifyChromePolicies(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return err
+	}
+
+	targetKey := chromePolicyTargetKey(d)
+	orgUnitRequests := []*chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest{}
+	groupRequests := []*chromepolicy.GoogleChromePolicyVersionsV1ModifyGroupPolicyRequest{}
+	for _, v := range d.Get("policies").([]interface{}) {
+		policyValue, fields, err := expandChromePolicyValue(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Setting chrome policy %s on %s", policyValue.PolicySchema, targetKey.TargetResource)
+		orgUnitRequests = append(orgUnitRequests, &chromepolicy.GoogleChromePolicyVersionsV1ModifyOrgUnitPolicyRequest{
+			PolicyTargetKey: targetKey,
+			PolicyValue:     policyValue,
+			UpdateMask:      strings.Join(fields, ","),
+		})
+		groupRequests = append(groupRequests, &chromepolicy.GoogleChromePolicyVersionsV1ModifyGroupPolicyRequest{
+			PolicyTargetKey: targetKey,
+			PolicyValue:     policyValue,
+			UpdateMask:      strings.Join(fields, ","),
+		})
+	}
+
+	if _, ok := d.GetOk("group_id"); ok {
+		_, err = chromePolicySvc.Customers.Policies.Groups.BatchModify(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1BatchModifyGroupPoliciesRequest{
+			Requests: groupRequests,
+		}).Context(ctx).Do()
+		return err
+	}
+
+	_, err = chromePolicySvc.Customers.Policies.Orgunits.BatchModify(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1BatchModifyOrgUnitPoliciesRequest{
+		Requests: orgUnitRequests,
+	}).Context(ctx).Do()
+	return err
+}
+
+// inheritChromePolicies makes the target inherit the given policies from its
+// parent again, or for groups, deletes them.
+func inheritChromePolicies(ctx context.Context, config *Config, d *schema.ResourceData, schemaNames []string) error {
+	if len(schemaNames) == 0 {
+		return nil
+	}
+
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return err
+	}
+
+	targetKey := chromePolicyTargetKey(d)
+
+	if _, ok := d.GetOk("group_id"); ok {
+		requests := []*chromepolicy.GoogleChromePolicyVersionsV1DeleteGroupPolicyRequest{}
+		for _, schemaName := range schemaNames {
+			log.Printf("[DEBUG] Deleting chrome policy %s on %s", schemaName, targetKey.TargetResource)
+			requests = append(requests, &chromepolicy.GoogleChromePolicyVersionsV1DeleteGroupPolicyRequest{
+				PolicyTargetKey: targetKey,
+				PolicySchema:    schemaName,
+			})
+		}
+
+		_, err = chromePolicySvc.Customers.Policies.Groups.BatchDelete(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1BatchDeleteGroupPoliciesRequest{
+			Requests: requests,
+		}).Context(ctx).Do()
+		return err
+	}
+
+	requests := []*chromepolicy.GoogleChromePolicyVersionsV1InheritOrgUnitPolicyRequest{}
+	for _, schemaName := range schemaNames {
+		log.Printf("[DEBUG] Inheriting chrome policy %s on %s", schemaName, targetKey.TargetResource)
+		requests = append(requests, &chromepolicy.GoogleChromePolicyVersionsV1InheritOrgUnitPolicyRequest{
+			PolicyTargetKey: targetKey,
+			PolicySchema:    schemaName,
+		})
+	}
+
+	_, err = chromePolicySvc.Customers.Policies.Orgunits.BatchInherit(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1BatchInheritOrgUnitPoliciesRequest{
+		Requests: requests,
+	}).Context(ctx).Do()
+	return err
+}
+
+// resolveChromePolicy returns the value of a policy set directly on the
+// target, or nil when the target inherits it.
+func resolveChromePolicy(ctx context.Context, chromePolicySvc *chromepolicy.Service, customer string, targetKey *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey, schemaName string) (map[string]interface{}, error) {
+	pageToken := ""
+	for {
+		resp, err := chromePolicySvc.Customers.Policies.Resolve(customer, &chromepolicy.GoogleChromePolicyVersionsV1ResolveRequest{
+			PolicySchemaFilter: schemaName,
+			PolicyTargetKey:    targetKey,
+			PageToken:          pageToken,
+		}).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range resp.ResolvedPolicies {
+			if policy.Value == nil || policy.Value.PolicySchema != schemaName {
+				continue
+			}
+			if policy.SourceKey != nil && policy.SourceKey.TargetResource != targetKey.TargetResource {
+				log.Printf("[DEBUG] Chrome policy %s is inherited from %s", schemaName, policy.SourceKey.TargetResource)
+				return nil, nil
+			}
+
+			values := map[string]interface{}{}
+			if err := json.Unmarshal(policy.Value.Value, &values); err != nil {
+				return nil, err
+			}
+			return values, nil
+		}
+
+		if resp.NextPageToken == "" {
+			return nil, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// flattenChromePolicyValues encodes the resolved values of the configured
+// fields, keeping the configured encoding when the values are equal.
+func flattenChromePolicyValues(configured map[string]interface{}, resolved map[string]interface{}) (map[string]string, error) {
+	result := map[string]string{}
+	for field, v := range configured {
+		value, ok := resolved[field]
+		if !ok {
+			continue
+		}
+
+		var configuredValue interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &configuredValue); err == nil {
+			// Round-trip the resolved value so numbers compare equal
+			b, _ := json.Marshal(value)
+			var resolvedValue interface{}
+			json.Unmarshal(b, &resolvedValue)
+			if reflect.DeepEqual(configuredValue, resolvedValue) {
+				result[field] = v.(string)
+				continue
+			}
+		}
+
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		result[field] = string(b)
+	}
+	return result, nil
+}
+
+func resourceChromePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	if d.Get("org_unit_id").(string) == "" && d.Get("group_id").(string) == "" {
+		return diag.Errorf("one of org_unit_id or group_id must be set")
+	}
+
+	if err := modifyChromePolicies(ctx, config, d); err != nil {
+		return diag.Errorf("Error creating chrome policy: %s", err)
+	}
+
+	d.SetId(chromePolicyTargetKey(d).TargetResource)
+	log.Printf("[INFO] Created chrome policies on %s", d.Id())
+	return resourceChromePolicyRead(ctx, d, meta)
+}
+
+func resourceChromePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	if d.HasChange("policies") {
+		// Policies no longer configured go back to being inherited
+		old, new := d.GetChange("policies")
+		configured := map[string]bool{}
+		for _, v := range new.([]interface{}) {
+			configured[v.(map[string]interface{})["schema_name"].(string)] = true
+		}
+		removed := []string{}
+		for _, v := range old.([]interface{}) {
+			schemaName := v.(map[string]interface{})["schema_name"].(string)
+			if !configured[schemaName] {
+				removed = append(removed, schemaName)
+			}
+		}
+
+		if err := inheritChromePolicies(ctx, config, d, removed); err != nil {
+			return diag.Errorf("Error removing chrome policy: %s", err)
+		}
+		if err := modifyChromePolicies(ctx, config, d); err != nil {
+			return diag.Errorf("Error updating chrome policy: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated chrome policies on %s", d.Id())
+	return resourceChromePolicyRead(ctx, d, meta)
+}
+
+func resourceChromePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targetKey := chromePolicyTargetKey(d)
+	policies := []map[string]interface{}{}
+	for _, v := range d.Get("policies").([]interface{}) {
+		policy := v.(map[string]interface{})
+		schemaName := policy["schema_name"].(string)
+
+		resolved, err := resolveChromePolicy(ctx, chromePolicySvc, chromePolicyCustomer(d, config), targetKey, schemaName)
+		if err != nil {
+			return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("chrome policy %s", schemaName)))
+		}
+		if resolved == nil {
+			log.Printf("[WARN] Chrome policy %s is no longer set on %s", schemaName, targetKey.TargetResource)
+			continue
+		}
+
+		values, err := flattenChromePolicyValues(policy["schema_values"].(map[string]interface{}), resolved)
+		if err != nil {
+			return diag.Errorf("Error reading chrome policy %s: %s", schemaName, err)
+		}
+
+		policies = append(policies, map[string]interface{}{
+			"schema_name":   schemaName,
+			"schema_values": values,
+		})
+	}
+
+	d.Set("policies", policies)
+
+	return nil
+}
+
+func resourceChromePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	schemaNames := []string{}
+	for _, v := range d.Get("policies").([]interface{}) {
+		schemaNames = append(schemaNames, v.(map[string]interface{})["schema_name"].(string))
+	}
+
+	if err := inheritChromePolicies(ctx, config, d, schemaNames); err != nil {
+		return diag.Errorf("Error deleting chrome policy: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,157 @@
+package gsuite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// resourceChromePolicyFile uploads a file for a file-backed policy field,
+// like a wallpaper image. The download URI is used as the field value in
+// gsuite_chrome_policy. Uploaded files cannot be deleted, so destroying the
+// resource only removes it from state.
+func resourceChromePolicyFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChromePolicyFileCreate,
+		ReadContext:   resourceChromePolicyFileRead,
+		DeleteContext: resourceChromePolicyFileDelete,
+
+		CustomizeDiff: resourceChromePolicyFileCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// the fully qualified field the file is for, e.g.
+			// chrome.users.Wallpaper.wallpaperImage
+			"policy_field": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// path to a local file to upload
+			"source": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"content_base64"},
+			},
+
+			"content_base64": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source"},
+			},
+
+			// the content type of the uploaded content, guessed from the
+			// source extension when not set
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// md5 of the local content, changes trigger a new upload
+			"content_md5": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"download_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// the policy value referencing the file, e.g.
+			// {"downloadUri": "https://..."}
+			"value_json": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceChromePolicyFileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// content interpolated from other resources is only known at apply time
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content_base64") {
+		if err := d.SetNewComputed("content_md5"); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("content_md5")
+		}
+		return nil
+	}
+
+	content, _, err := readDriveFileContent(d)
+	if err != nil {
+		return err
+	}
+
+	if sum := md5Hex(content); sum != d.Get("content_md5").(string) {
+		log.Printf("[DEBUG] Content for %s changed, planning an upload", d.Get("policy_field").(string))
+		if err := d.SetNew("content_md5", sum); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("content_md5")
+		}
+	}
+	return nil
+}
+
+func resourceChromePolicyFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	content, contentType, err := readDriveFileContent(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyField := d.Get("policy_field").(string)
+	resp, err := chromePolicySvc.Media.Upload(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1UploadPolicyFileRequest{
+		PolicyField: policyField,
+	}).Media(bytes.NewReader(content), googleapi.ContentType(contentType)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error uploading chrome policy file: %s", err)
+	}
+
+	d.SetId(resp.DownloadUri)
+	d.Set("content_md5", md5Hex(content))
+	d.Set("content_type", contentType)
+	log.Printf("[INFO] Uploaded chrome policy file for %s", policyField)
+	return resourceChromePolicyFileRead(ctx, d, meta)
+}
+
+// resourceChromePolicyFileRead only derives attributes, the API has no call
+// to read uploaded files back.
+func resourceChromePolicyFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.Set("download_uri", d.Id())
+	d.Set("value_json", fmt.Sprintf(`{"downloadUri":%q}`, d.Id()))
+	return nil
+}
+
+func resourceChromePolicyFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Chrome policy files cannot be deleted, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
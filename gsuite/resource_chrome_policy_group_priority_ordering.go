@@ -0,0 +1,121 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	chromepolicy "google.golang.org/api/chromepolicy/v1"
+)
+
+// resourceChromePolicyGroupPriorityOrdering orders the groups an app policy
+// is set on, the first group wins when a user is in several of them. The
+// ordering always exists once the policy is set on groups, so destroying the
+// resource only removes it from state.
+func resourceChromePolicyGroupPriorityOrdering() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChromePolicyGroupPriorityOrderingUpdate,
+		ReadContext:   resourceChromePolicyGroupPriorityOrderingRead,
+		UpdateContext: resourceChromePolicyGroupPriorityOrderingUpdate,
+		DeleteContext: resourceChromePolicyGroupPriorityOrderingDelete,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// e.g. chrome.users.apps.InstallType
+			"schema_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// e.g. chrome:nngceckbapebfimnlniiiahkandclblb
+			"app_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// group IDs, highest priority first
+			"group_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"policy_namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func chromePolicyAppTargetKey(d *schema.ResourceData) *chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey {
+	return &chromepolicy.GoogleChromePolicyVersionsV1PolicyTargetKey{
+		AdditionalTargetKeys: map[string]string{
+			"app_id": d.Get("app_id").(string),
+		},
+	}
+}
+
+func resourceChromePolicyGroupPriorityOrderingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schemaName := d.Get("schema_name").(string)
+	groupIDs := convertStringList(d.Get("group_ids").([]interface{}))
+
+	log.Printf("[DEBUG] Ordering %d groups for chrome policy %s", len(groupIDs), schemaName)
+	_, err = chromePolicySvc.Customers.Policies.Groups.UpdateGroupPriorityOrdering(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1UpdateGroupPriorityOrderingRequest{
+		PolicySchema:    schemaName,
+		PolicyTargetKey: chromePolicyAppTargetKey(d),
+		GroupIds:        groupIDs,
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating chrome policy group priority ordering: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", schemaName, d.Get("app_id").(string)))
+	log.Printf("[INFO] Updated chrome policy group priority ordering: %s", d.Id())
+	return resourceChromePolicyGroupPriorityOrderingRead(ctx, d, meta)
+}
+
+func resourceChromePolicyGroupPriorityOrderingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	chromePolicySvc, err := config.chromePolicyService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := chromePolicySvc.Customers.Policies.Groups.ListGroupPriorityOrdering(chromePolicyCustomer(d, config), &chromepolicy.GoogleChromePolicyVersionsV1ListGroupPriorityOrderingRequest{
+		PolicySchema:    d.Get("schema_name").(string),
+		PolicyTargetKey: chromePolicyAppTargetKey(d),
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("chrome policy group priority ordering %s", d.Id())))
+	}
+
+	d.Set("group_ids", resp.GroupIds)
+	d.Set("policy_namespace", resp.PolicyNamespace)
+
+	return nil
+}
+
+func resourceChromePolicyGroupPriorityOrderingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Chrome policy group priority orderings cannot be deleted, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,149 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// chromeosMoveBatchSize is the maximum number of devices moved per call.
+const chromeosMoveBatchSize = 50
+
+// resourceChromeosDeviceOrgUnit places a set of ChromeOS devices in an org
+// unit. Devices moved elsewhere are moved back on the next apply, and
+// destroying the resource leaves the devices where they are.
+func resourceChromeosDeviceOrgUnit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChromeosDeviceOrgUnitCreate,
+		ReadContext:   resourceChromeosDeviceOrgUnitRead,
+		UpdateContext: resourceChromeosDeviceOrgUnitUpdate,
+		DeleteContext: resourceChromeosDeviceOrgUnitDelete,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"org_unit_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// the unique IDs of the devices, not their serial numbers
+			"device_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+// moveChromeosDevices moves the devices to the org unit in batches.
+func moveChromeosDevices(ctx context.Context, config *Config, customerID, orgUnitPath string, deviceIDs []string) error {
+	directorySvc, err := config.directoryService(directory.AdminDirectoryDeviceChromeosScope)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(deviceIDs); start += chromeosMoveBatchSize {
+		end := start + chromeosMoveBatchSize
+		if end > len(deviceIDs) {
+			end = len(deviceIDs)
+		}
+
+		log.Printf("[DEBUG] Moving %d devices to %s", end-start, orgUnitPath)
+		err := directorySvc.Chromeosdevices.MoveDevicesToOu(customerID, orgUnitPath, &directory.ChromeOsMoveDevicesToOu{
+			DeviceIds: deviceIDs[start:end],
+		}).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("Error moving devices to %s: %s", orgUnitPath, err)
+		}
+	}
+	return nil
+}
+
+func resourceChromeosDeviceOrgUnitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	deviceIDs := convertStringList(d.Get("device_ids").(*schema.Set).List())
+	if err := moveChromeosDevices(ctx, config, customerID(d, config), orgUnitPath, deviceIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+	log.Printf("[INFO] Moved %d devices to %s", len(deviceIDs), orgUnitPath)
+	return resourceChromeosDeviceOrgUnitRead(ctx, d, meta)
+}
+
+func resourceChromeosDeviceOrgUnitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	orgUnitPath := d.Get("org_unit_path").(string)
+	deviceIDs := d.Get("device_ids").(*schema.Set)
+
+	// Only move the devices that are not in place yet, unless the target
+	// itself changed
+	if !d.HasChange("org_unit_path") {
+		old, _ := d.GetChange("device_ids")
+		deviceIDs = deviceIDs.Difference(old.(*schema.Set))
+	}
+
+	if err := moveChromeosDevices(ctx, config, customerID(d, config), orgUnitPath, convertStringList(deviceIDs.List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Updated placement of devices in %s", orgUnitPath)
+	return resourceChromeosDeviceOrgUnitRead(ctx, d, meta)
+}
+
+func resourceChromeosDeviceOrgUnitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	directorySvc, err := config.directoryService(directory.AdminDirectoryDeviceChromeosScope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Devices no longer in the org unit are left out, so they show up as a
+	// change on the next plan
+	orgUnitPath := d.Get("org_unit_path").(string)
+	placed := []string{}
+	for _, v := range d.Get("device_ids").(*schema.Set).List() {
+		device, err := directorySvc.Chromeosdevices.Get(customerID(d, config), v.(string)).Projection("BASIC").Context(ctx).Do()
+		if err != nil {
+			if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+				log.Printf("[WARN] Device %s is gone", v.(string))
+				continue
+			}
+			return diag.Errorf("Error reading device %s: %s", v.(string), err)
+		}
+
+		if device.OrgUnitPath != orgUnitPath {
+			log.Printf("[DEBUG] Device %s is in %s instead of %s", v.(string), device.OrgUnitPath, orgUnitPath)
+			continue
+		}
+		placed = append(placed, device.DeviceId)
+	}
+
+	d.Set("device_ids", placed)
+
+	return nil
+}
+
+func resourceChromeosDeviceOrgUnitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Leaving devices of %s in place", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,236 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	classroom "google.golang.org/api/classroom/v1"
+)
+
+func resourceClassroomCourse() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClassroomCourseCreate,
+		ReadContext:   resourceClassroomCourseRead,
+		UpdateContext: resourceClassroomCourseUpdate,
+		DeleteContext: resourceClassroomCourseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"section": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description_heading": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"room": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// email or user ID of the owner, who is also made a teacher. A new
+			// owner has to be a teacher of the course already.
+			"owner_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// PROVISIONED, ACTIVE or ARCHIVED
+			"course_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PROVISIONED",
+			},
+
+			// archive the course on destroy instead of deleting it
+			"archive_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enrollment_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"alternate_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceClassroomCourseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	course := &classroom.Course{
+		Name:               d.Get("name").(string),
+		Section:            d.Get("section").(string),
+		DescriptionHeading: d.Get("description_heading").(string),
+		Description:        d.Get("description").(string),
+		Room:               d.Get("room").(string),
+		OwnerId:            d.Get("owner_id").(string),
+		CourseState:        d.Get("course_state").(string),
+	}
+
+	createdCourse, err := classroomSvc.Courses.Create(course).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating classroom course: %s", err)
+	}
+
+	d.SetId(createdCourse.Id)
+	log.Printf("[INFO] Created classroom course: %s", createdCourse.Name)
+	return resourceClassroomCourseRead(ctx, d, meta)
+}
+
+func resourceClassroomCourseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	course := &classroom.Course{}
+	updateMask := []string{}
+
+	if d.HasChange("name") {
+		log.Printf("[DEBUG] Updating classroom course name: %s", d.Get("name").(string))
+		course.Name = d.Get("name").(string)
+		updateMask = append(updateMask, "name")
+	}
+	if d.HasChange("section") {
+		log.Printf("[DEBUG] Updating classroom course section: %s", d.Get("section").(string))
+		course.Section = d.Get("section").(string)
+		updateMask = append(updateMask, "section")
+	}
+	if d.HasChange("description_heading") {
+		log.Printf("[DEBUG] Updating classroom course description_heading: %s", d.Get("description_heading").(string))
+		course.DescriptionHeading = d.Get("description_heading").(string)
+		updateMask = append(updateMask, "descriptionHeading")
+	}
+	if d.HasChange("description") {
+		log.Printf("[DEBUG] Updating classroom course description")
+		course.Description = d.Get("description").(string)
+		updateMask = append(updateMask, "description")
+	}
+	if d.HasChange("room") {
+		log.Printf("[DEBUG] Updating classroom course room: %s", d.Get("room").(string))
+		course.Room = d.Get("room").(string)
+		updateMask = append(updateMask, "room")
+	}
+	if d.HasChange("owner_id") {
+		log.Printf("[DEBUG] Transferring classroom course to: %s", d.Get("owner_id").(string))
+		course.OwnerId = d.Get("owner_id").(string)
+		updateMask = append(updateMask, "ownerId")
+	}
+	if d.HasChange("course_state") {
+		log.Printf("[DEBUG] Updating classroom course state: %s", d.Get("course_state").(string))
+		course.CourseState = d.Get("course_state").(string)
+		updateMask = append(updateMask, "courseState")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := classroomSvc.Courses.Patch(d.Id(), course).UpdateMask(strings.Join(updateMask, ",")).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating classroom course: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated classroom course: %s", d.Id())
+	return resourceClassroomCourseRead(ctx, d, meta)
+}
+
+func resourceClassroomCourseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	course, err := classroomSvc.Courses.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("classroom course %s", d.Id())))
+	}
+
+	d.Set("name", course.Name)
+	d.Set("section", course.Section)
+	d.Set("description_heading", course.DescriptionHeading)
+	d.Set("description", course.Description)
+	d.Set("room", course.Room)
+	d.Set("course_state", course.CourseState)
+	d.Set("enrollment_code", course.EnrollmentCode)
+	d.Set("alternate_link", course.AlternateLink)
+	d.Set("creation_time", course.CreationTime)
+
+	// The API returns the owner's user ID, keep the configured email when it
+	// refers to the same user
+	owner := d.Get("owner_id").(string)
+	if strings.Contains(owner, "@") {
+		user, err := config.directory.Users.Get(owner).Context(ctx).Do()
+		if err == nil && user.Id == course.OwnerId {
+			return nil
+		}
+	}
+	d.Set("owner_id", course.OwnerId)
+
+	return nil
+}
+
+func resourceClassroomCourseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("archive_on_destroy").(bool) {
+		log.Printf("[DEBUG] Archiving classroom course %s", d.Id())
+		_, err = classroomSvc.Courses.Patch(d.Id(), &classroom.Course{CourseState: "ARCHIVED"}).UpdateMask("courseState").Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error archiving classroom course: %s", err)
+		}
+	} else {
+		_, err = classroomSvc.Courses.Delete(d.Id()).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error deleting classroom course: %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,141 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	classroom "google.golang.org/api/classroom/v1"
+)
+
+func resourceClassroomStudent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClassroomStudentCreate,
+		ReadContext:   resourceClassroomStudentRead,
+		DeleteContext: resourceClassroomStudentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"course_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// email or ID of the user
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// lets the user enroll themselves, only used when the
+			// impersonated user is the student
+			"enrollment_code": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				ForceNew:  true,
+			},
+
+			"email_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"full_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceClassroomStudentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	courseID := d.Get("course_id").(string)
+	student := &classroom.Student{
+		UserId: d.Get("user_id").(string),
+	}
+
+	call := classroomSvc.Courses.Students.Create(courseID, student)
+	if v, ok := d.GetOk("enrollment_code"); ok {
+		call = call.EnrollmentCode(v.(string))
+	}
+
+	createdStudent, err := call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating classroom student: %s", err)
+	}
+
+	// The ID uses the user ID returned, as emails can change
+	d.SetId(fmt.Sprintf("%s/%s", courseID, createdStudent.UserId))
+	log.Printf("[INFO] Added student %s to classroom course %s", student.UserId, courseID)
+	return resourceClassroomStudentRead(ctx, d, meta)
+}
+
+func resourceClassroomStudentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	courseID, userID := parts[0], parts[1]
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	student, err := classroomSvc.Courses.Students.Get(courseID, userID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("classroom student %s", d.Id())))
+	}
+
+	d.Set("course_id", courseID)
+	if _, ok := d.GetOk("user_id"); !ok {
+		d.Set("user_id", student.UserId)
+	}
+	if student.Profile != nil {
+		d.Set("email_address", student.Profile.EmailAddress)
+		if student.Profile.Name != nil {
+			d.Set("full_name", student.Profile.Name.FullName)
+		}
+	}
+
+	return nil
+}
+
+func resourceClassroomStudentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = classroomSvc.Courses.Students.Delete(parts[0], parts[1]).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting classroom student: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
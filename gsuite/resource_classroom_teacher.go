@@ -0,0 +1,127 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	classroom "google.golang.org/api/classroom/v1"
+)
+
+func resourceClassroomTeacher() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClassroomTeacherCreate,
+		ReadContext:   resourceClassroomTeacherRead,
+		DeleteContext: resourceClassroomTeacherDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"course_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// email or ID of the user
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"email_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"full_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceClassroomTeacherCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	courseID := d.Get("course_id").(string)
+	teacher := &classroom.Teacher{
+		UserId: d.Get("user_id").(string),
+	}
+
+	createdTeacher, err := classroomSvc.Courses.Teachers.Create(courseID, teacher).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating classroom teacher: %s", err)
+	}
+
+	// The ID uses the user ID returned, as emails can change
+	d.SetId(fmt.Sprintf("%s/%s", courseID, createdTeacher.UserId))
+	log.Printf("[INFO] Added teacher %s to classroom course %s", teacher.UserId, courseID)
+	return resourceClassroomTeacherRead(ctx, d, meta)
+}
+
+func resourceClassroomTeacherRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	courseID, userID := parts[0], parts[1]
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teacher, err := classroomSvc.Courses.Teachers.Get(courseID, userID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("classroom teacher %s", d.Id())))
+	}
+
+	d.Set("course_id", courseID)
+	if _, ok := d.GetOk("user_id"); !ok {
+		d.Set("user_id", teacher.UserId)
+	}
+	if teacher.Profile != nil {
+		d.Set("email_address", teacher.Profile.EmailAddress)
+		if teacher.Profile.Name != nil {
+			d.Set("full_name", teacher.Profile.Name.FullName)
+		}
+	}
+
+	return nil
+}
+
+func resourceClassroomTeacherDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	classroomSvc, err := config.classroomService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = classroomSvc.Courses.Teachers.Delete(parts[0], parts[1]).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting classroom teacher: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
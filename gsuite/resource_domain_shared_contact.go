@@ -0,0 +1,417 @@
+package gsuite
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+)
+
+// The Domain Shared Contacts API is an Atom based GData API, which is not
+// covered by the generated Go clients.
+const (
+	sharedContactsBaseURL = "https://www.google.com/m8/feeds/"
+	gdataRelPrefix        = "http://schemas.google.com/g/2005#"
+	gdataKindScheme       = "http://schemas.google.com/g/2005#kind"
+	gdataContactKind      = "http://schemas.google.com/contact/2008#contact"
+)
+
+type sharedContactEntry struct {
+	XMLName       xml.Name                    `xml:"http://www.w3.org/2005/Atom entry"`
+	ETag          string                      `xml:"http://schemas.google.com/g/2005 etag,attr,omitempty"`
+	ID            string                      `xml:"http://www.w3.org/2005/Atom id,omitempty"`
+	Category      sharedContactCategory       `xml:"http://www.w3.org/2005/Atom category"`
+	Content       string                      `xml:"http://www.w3.org/2005/Atom content,omitempty"`
+	Links         []sharedContactLink         `xml:"http://www.w3.org/2005/Atom link"`
+	Name          *sharedContactName          `xml:"http://schemas.google.com/g/2005 name"`
+	Emails        []sharedContactEmail        `xml:"http://schemas.google.com/g/2005 email"`
+	PhoneNumbers  []sharedContactPhoneNumber  `xml:"http://schemas.google.com/g/2005 phoneNumber"`
+	Organizations []sharedContactOrganization `xml:"http://schemas.google.com/g/2005 organization"`
+}
+
+type sharedContactCategory struct {
+	Scheme string `xml:"scheme,attr"`
+	Term   string `xml:"term,attr"`
+}
+
+type sharedContactLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type sharedContactName struct {
+	GivenName  string `xml:"http://schemas.google.com/g/2005 givenName,omitempty"`
+	FamilyName string `xml:"http://schemas.google.com/g/2005 familyName,omitempty"`
+	FullName   string `xml:"http://schemas.google.com/g/2005 fullName,omitempty"`
+}
+
+type sharedContactEmail struct {
+	Address     string `xml:"address,attr"`
+	Rel         string `xml:"rel,attr,omitempty"`
+	Primary     bool   `xml:"primary,attr,omitempty"`
+	DisplayName string `xml:"displayName,attr,omitempty"`
+}
+
+type sharedContactPhoneNumber struct {
+	Number  string `xml:",chardata"`
+	Rel     string `xml:"rel,attr,omitempty"`
+	Primary bool   `xml:"primary,attr,omitempty"`
+}
+
+type sharedContactOrganization struct {
+	Rel        string `xml:"rel,attr,omitempty"`
+	Primary    bool   `xml:"primary,attr,omitempty"`
+	Name       string `xml:"http://schemas.google.com/g/2005 orgName,omitempty"`
+	Title      string `xml:"http://schemas.google.com/g/2005 orgTitle,omitempty"`
+	Department string `xml:"http://schemas.google.com/g/2005 orgDepartment,omitempty"`
+}
+
+// editURL returns the URL updates and deletes of the entry go to.
+func (e *sharedContactEntry) editURL() string {
+	for _, link := range e.Links {
+		if link.Rel == "edit" {
+			return link.Href
+		}
+	}
+	return ""
+}
+
+func resourceDomainSharedContact() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainSharedContactCreate,
+		ReadContext:   resourceDomainSharedContactRead,
+		UpdateContext: resourceDomainSharedContactUpdate,
+		DeleteContext: resourceDomainSharedContactDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"given_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"family_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"full_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"notes": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"email": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// work, home or other
+						"rel": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "work",
+						},
+						"primary": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// work, home, mobile, fax or other
+						"rel": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "work",
+						},
+						"primary": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"title": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"department": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// the version of the contact last read, updates made by others
+			// in the meantime are not overwritten
+			"etag": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandSharedContactEntry(d *schema.ResourceData) *sharedContactEntry {
+	entry := &sharedContactEntry{
+		Category: sharedContactCategory{
+			Scheme: gdataKindScheme,
+			Term:   gdataContactKind,
+		},
+		Content: d.Get("notes").(string),
+		Name: &sharedContactName{
+			GivenName:  d.Get("given_name").(string),
+			FamilyName: d.Get("family_name").(string),
+			FullName:   d.Get("full_name").(string),
+		},
+	}
+
+	for _, v := range d.Get("email").([]interface{}) {
+		email := v.(map[string]interface{})
+		entry.Emails = append(entry.Emails, sharedContactEmail{
+			Address:     email["address"].(string),
+			Rel:         gdataRelPrefix + email["rel"].(string),
+			Primary:     email["primary"].(bool),
+			DisplayName: email["display_name"].(string),
+		})
+	}
+
+	for _, v := range d.Get("phone_number").([]interface{}) {
+		phoneNumber := v.(map[string]interface{})
+		entry.PhoneNumbers = append(entry.PhoneNumbers, sharedContactPhoneNumber{
+			Number:  phoneNumber["number"].(string),
+			Rel:     gdataRelPrefix + phoneNumber["rel"].(string),
+			Primary: phoneNumber["primary"].(bool),
+		})
+	}
+
+	for _, v := range d.Get("organization").([]interface{}) {
+		organization := v.(map[string]interface{})
+		entry.Organizations = append(entry.Organizations, sharedContactOrganization{
+			Rel:        gdataRelPrefix + "work",
+			Primary:    true,
+			Name:       organization["name"].(string),
+			Title:      organization["title"].(string),
+			Department: organization["department"].(string),
+		})
+	}
+
+	return entry
+}
+
+// doSharedContactRequest sends the entry, if any, and decodes the entry in
+// the response, if any. API errors are returned as *googleapi.Error.
+func doSharedContactRequest(ctx context.Context, config *Config, method, url, etag string, entry *sharedContactEntry) (*sharedContactEntry, error) {
+	client, err := config.sharedContactsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	if entry != nil {
+		if err := xml.NewEncoder(&body).Encode(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("GData-Version", "3.0")
+	req.Header.Set("Content-Type", "application/atom+xml")
+	req.Header.Set("User-Agent", config.userAgent)
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return nil, err
+	}
+	if method == "DELETE" {
+		return nil, nil
+	}
+
+	result := &sharedContactEntry{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sharedContactsFeedURL returns the URL of the contacts feed of a domain.
+func sharedContactsFeedURL(config *Config, domain string) string {
+	return fmt.Sprintf("%scontacts/%s/full", config.endpoint("shared_contacts", sharedContactsBaseURL), domain)
+}
+
+// sharedContactURL returns the URL of a contact from its ID.
+func sharedContactURL(config *Config, domain, id string) string {
+	return sharedContactsFeedURL(config, domain) + "/" + id
+}
+
+func resourceDomainSharedContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	domain := d.Get("domain").(string)
+	createdEntry, err := doSharedContactRequest(ctx, config, "POST", sharedContactsFeedURL(config, domain), "", expandSharedContactEntry(d))
+	if err != nil {
+		return diag.Errorf("Error creating domain shared contact: %s", err)
+	}
+
+	// Entry IDs are URLs ending in the contact ID
+	id := createdEntry.ID[strings.LastIndex(createdEntry.ID, "/")+1:]
+	d.SetId(fmt.Sprintf("%s/%s", domain, id))
+	log.Printf("[INFO] Created domain shared contact: %s", d.Id())
+	return resourceDomainSharedContactRead(ctx, d, meta)
+}
+
+func resourceDomainSharedContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entry := expandSharedContactEntry(d)
+	_, err = doSharedContactRequest(ctx, config, "PUT", sharedContactURL(config, parts[0], parts[1]), d.Get("etag").(string), entry)
+	if err != nil {
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 412 {
+			return diag.Errorf("Error updating domain shared contact: %s was changed since it was last read, refresh and try again", d.Id())
+		}
+		return diag.Errorf("Error updating domain shared contact: %s", err)
+	}
+
+	log.Printf("[INFO] Updated domain shared contact: %s", d.Id())
+	return resourceDomainSharedContactRead(ctx, d, meta)
+}
+
+func resourceDomainSharedContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	domain, id := parts[0], parts[1]
+
+	entry, err := doSharedContactRequest(ctx, config, "GET", sharedContactURL(config, domain, id), "", nil)
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("domain shared contact %s", d.Id())))
+	}
+
+	d.Set("domain", domain)
+	d.Set("etag", entry.ETag)
+	d.Set("notes", entry.Content)
+	if entry.Name != nil {
+		d.Set("given_name", entry.Name.GivenName)
+		d.Set("family_name", entry.Name.FamilyName)
+		d.Set("full_name", entry.Name.FullName)
+	}
+
+	emails := []map[string]interface{}{}
+	for _, email := range entry.Emails {
+		emails = append(emails, map[string]interface{}{
+			"address":      email.Address,
+			"rel":          strings.TrimPrefix(email.Rel, gdataRelPrefix),
+			"primary":      email.Primary,
+			"display_name": email.DisplayName,
+		})
+	}
+	d.Set("email", emails)
+
+	phoneNumbers := []map[string]interface{}{}
+	for _, phoneNumber := range entry.PhoneNumbers {
+		phoneNumbers = append(phoneNumbers, map[string]interface{}{
+			"number":  phoneNumber.Number,
+			"rel":     strings.TrimPrefix(phoneNumber.Rel, gdataRelPrefix),
+			"primary": phoneNumber.Primary,
+		})
+	}
+	d.Set("phone_number", phoneNumbers)
+
+	organizations := []map[string]interface{}{}
+	for _, organization := range entry.Organizations {
+		organizations = append(organizations, map[string]interface{}{
+			"name":       organization.Name,
+			"title":      organization.Title,
+			"department": organization.Department,
+		})
+	}
+	d.Set("organization", organizations)
+
+	return nil
+}
+
+func resourceDomainSharedContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = doSharedContactRequest(ctx, config, "DELETE", sharedContactURL(config, parts[0], parts[1]), d.Get("etag").(string), nil)
+	if err != nil {
+		return diag.Errorf("Error deleting domain shared contact: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
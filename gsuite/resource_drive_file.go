@@ -0,0 +1,327 @@
+package gsuite
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"mime"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drive "google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// driveConversionMimeTypes maps the convert_to values to the Google Docs
+// formats the uploaded content is converted to.
+var driveConversionMimeTypes = map[string]string{
+	"document":     "application/vnd.google-apps.document",
+	"spreadsheet":  "application/vnd.google-apps.spreadsheet",
+	"presentation": "application/vnd.google-apps.presentation",
+	"drawing":      "application/vnd.google-apps.drawing",
+}
+
+func resourceDriveFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDriveFileCreate,
+		ReadContext:   resourceDriveFileRead,
+		UpdateContext: resourceDriveFileUpdate,
+		DeleteContext: resourceDriveFileDelete,
+
+		CustomizeDiff: resourceDriveFileCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// the user to act as instead of the provider's
+			// impersonated_user_email
+			"impersonated_user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// ID of the parent folder, or of a shared drive for its root
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// path to a local file to upload
+			"source": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content_base64"},
+			},
+
+			"content_base64": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+
+			// the content type of the uploaded content, guessed from the
+			// source extension when not set
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// document, spreadsheet, presentation or drawing
+			"convert_to": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// trash the file on destroy unless this is set
+			"delete_permanently": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// md5 of the local content, changes trigger a new upload
+			"content_md5": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// md5 reported by Drive, empty for converted files
+			"md5_checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mime_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"drive_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"web_view_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// driveFileGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff, so the content can be read during plan and apply.
+type driveFileGetter interface {
+	Get(string) interface{}
+}
+
+// readDriveFileContent returns the configured content and its content type.
+func readDriveFileContent(d driveFileGetter) ([]byte, string, error) {
+	contentType := d.Get("content_type").(string)
+
+	if v := d.Get("source").(string); v != "" {
+		content, err := ioutil.ReadFile(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error reading %s: %s", v, err)
+		}
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(v))
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		return content, contentType, nil
+	}
+
+	if v := d.Get("content_base64").(string); v != "" {
+		content, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error decoding content_base64: %s", err)
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		return content, contentType, nil
+	}
+
+	return nil, "", fmt.Errorf("one of source or content_base64 must be set")
+}
+
+func md5Hex(content []byte) string {
+	hash := md5.Sum(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// resourceDriveFileCustomizeDiff plans a new upload whenever the local content
+// no longer matches what was last uploaded.
+func resourceDriveFileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// content interpolated from other resources is only known at apply time
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content_base64") {
+		return d.SetNewComputed("content_md5")
+	}
+
+	content, _, err := readDriveFileContent(d)
+	if err != nil {
+		return err
+	}
+
+	if sum := md5Hex(content); sum != d.Get("content_md5").(string) {
+		log.Printf("[DEBUG] Content of %s changed, planning an upload", d.Get("name").(string))
+		return d.SetNew("content_md5", sum)
+	}
+	return nil
+}
+
+func resourceDriveFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	content, contentType, err := readDriveFileContent(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	file := &drive.File{
+		Name:    d.Get("name").(string),
+		Parents: []string{d.Get("parent_id").(string)},
+	}
+
+	if v, ok := d.GetOk("convert_to"); ok {
+		mimeType, ok := driveConversionMimeTypes[v.(string)]
+		if !ok {
+			return diag.Errorf("Unsupported convert_to value %q", v.(string))
+		}
+		log.Printf("[DEBUG] Converting %s to %s", file.Name, mimeType)
+		file.MimeType = mimeType
+	}
+
+	createdFile, err := driveSvc.Files.Create(file).
+		Media(bytes.NewReader(content), googleapi.ContentType(contentType)).
+		SupportsAllDrives(true).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error uploading file: %s", err)
+	}
+
+	d.SetId(createdFile.Id)
+	d.Set("content_md5", md5Hex(content))
+	d.Set("content_type", contentType)
+	log.Printf("[INFO] Uploaded file: %s", createdFile.Name)
+	return resourceDriveFileRead(ctx, d, meta)
+}
+
+func resourceDriveFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	file := &drive.File{}
+	call := driveSvc.Files.Update(d.Id(), file).SupportsAllDrives(true)
+
+	if d.HasChange("name") {
+		log.Printf("[DEBUG] Updating file name: %s", d.Get("name").(string))
+		file.Name = d.Get("name").(string)
+	}
+	if d.HasChange("parent_id") {
+		old, new := d.GetChange("parent_id")
+		log.Printf("[DEBUG] Moving file from %s to %s", old.(string), new.(string))
+		call = call.AddParents(new.(string)).RemoveParents(old.(string))
+	}
+	if d.HasChange("content_md5") || d.HasChange("content_type") {
+		content, contentType, err := readDriveFileContent(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[DEBUG] Uploading new content for %s", d.Id())
+		call = call.Media(bytes.NewReader(content), googleapi.ContentType(contentType))
+		d.Set("content_md5", md5Hex(content))
+	}
+
+	updatedFile, err := call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating file: %s", err)
+	}
+
+	log.Printf("[INFO] Updated file: %s", updatedFile.Name)
+	return resourceDriveFileRead(ctx, d, meta)
+}
+
+func resourceDriveFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	file, err := driveSvc.Files.Get(d.Id()).
+		SupportsAllDrives(true).
+		Fields("id, name, parents, driveId, mimeType, md5Checksum, webViewLink, trashed").
+		Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("file %s", d.Id())))
+	}
+
+	if file.Trashed {
+		log.Printf("[WARN] Removing file %s because it was trashed", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Content changed in Drive shows up as a different checksum, which makes
+	// the next plan upload the local content again.
+	if file.Md5Checksum != "" {
+		d.Set("content_md5", file.Md5Checksum)
+	}
+
+	d.Set("name", file.Name)
+	if len(file.Parents) > 0 {
+		d.Set("parent_id", file.Parents[0])
+	}
+	d.Set("md5_checksum", file.Md5Checksum)
+	d.Set("mime_type", file.MimeType)
+	d.Set("drive_id", file.DriveId)
+	d.Set("web_view_link", file.WebViewLink)
+
+	return nil
+}
+
+func resourceDriveFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("delete_permanently").(bool) {
+		err = driveSvc.Files.Delete(d.Id()).SupportsAllDrives(true).Context(ctx).Do()
+	} else {
+		_, err = driveSvc.Files.Update(d.Id(), &drive.File{Trashed: true}).SupportsAllDrives(true).Context(ctx).Do()
+	}
+	if err != nil {
+		return diag.Errorf("Error deleting file: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
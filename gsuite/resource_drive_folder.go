@@ -0,0 +1,216 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drive "google.golang.org/api/drive/v3"
+)
+
+const driveFolderMimeType = "application/vnd.google-apps.folder"
+
+func resourceDriveFolder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDriveFolderCreate,
+		ReadContext:   resourceDriveFolderRead,
+		UpdateContext: resourceDriveFolderUpdate,
+		DeleteContext: resourceDriveFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the user to act as instead of the provider's
+			// impersonated_user_email
+			"impersonated_user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// ID of the parent folder, or of a shared drive for its root
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// trash the folder on destroy unless this is set
+			"delete_permanently": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"drive_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"web_view_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// escapeDriveQuery escapes a value for use in a Drive search query.
+func escapeDriveQuery(v string) string {
+	return strings.Replace(strings.Replace(v, "\\", "\\\\", -1), "'", "\\'", -1)
+}
+
+// findDriveFolder looks up a folder by name under the given parent, so an
+// existing folder is adopted instead of creating a duplicate.
+func findDriveFolder(ctx context.Context, driveSvc *drive.Service, name, parentID string) (*drive.File, error) {
+	query := fmt.Sprintf("name = '%s' and '%s' in parents and mimeType = '%s' and trashed = false",
+		escapeDriveQuery(name), escapeDriveQuery(parentID), driveFolderMimeType)
+
+	resp, err := driveSvc.Files.List().
+		Q(query).
+		SupportsAllDrives(true).
+		IncludeItemsFromAllDrives(true).
+		Fields("files(id, name)").
+		Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(resp.Files) {
+	case 0:
+		return nil, nil
+	case 1:
+		return resp.Files[0], nil
+	default:
+		return nil, fmt.Errorf("found %d folders named %q in %s", len(resp.Files), name, parentID)
+	}
+}
+
+func resourceDriveFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	parentID := d.Get("parent_id").(string)
+
+	existing, err := findDriveFolder(ctx, driveSvc, name, parentID)
+	if err != nil {
+		return diag.Errorf("Error looking up folder %s: %s", name, err)
+	}
+	if existing != nil {
+		log.Printf("[INFO] Folder %s already exists in %s, adopting %s", name, parentID, existing.Id)
+		d.SetId(existing.Id)
+		return resourceDriveFolderRead(ctx, d, meta)
+	}
+
+	folder := &drive.File{
+		Name:     name,
+		MimeType: driveFolderMimeType,
+		Parents:  []string{parentID},
+	}
+
+	createdFolder, err := driveSvc.Files.Create(folder).SupportsAllDrives(true).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating folder: %s", err)
+	}
+
+	d.SetId(createdFolder.Id)
+	log.Printf("[INFO] Created folder: %s", createdFolder.Name)
+	return resourceDriveFolderRead(ctx, d, meta)
+}
+
+func resourceDriveFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder := &drive.File{}
+	call := driveSvc.Files.Update(d.Id(), folder).SupportsAllDrives(true)
+
+	if d.HasChange("name") {
+		log.Printf("[DEBUG] Updating folder name: %s", d.Get("name").(string))
+		folder.Name = d.Get("name").(string)
+	}
+	if d.HasChange("parent_id") {
+		old, new := d.GetChange("parent_id")
+		log.Printf("[DEBUG] Moving folder from %s to %s", old.(string), new.(string))
+		call = call.AddParents(new.(string)).RemoveParents(old.(string))
+	}
+
+	updatedFolder, err := call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating folder: %s", err)
+	}
+
+	log.Printf("[INFO] Updated folder: %s", updatedFolder.Name)
+	return resourceDriveFolderRead(ctx, d, meta)
+}
+
+func resourceDriveFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder, err := driveSvc.Files.Get(d.Id()).
+		SupportsAllDrives(true).
+		Fields("id, name, parents, driveId, webViewLink, trashed").
+		Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("folder %s", d.Id())))
+	}
+
+	if folder.Trashed {
+		log.Printf("[WARN] Removing folder %s because it was trashed", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", folder.Name)
+	if len(folder.Parents) > 0 {
+		d.Set("parent_id", folder.Parents[0])
+	}
+	d.Set("drive_id", folder.DriveId)
+	d.Set("web_view_link", folder.WebViewLink)
+
+	return nil
+}
+
+func resourceDriveFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("delete_permanently").(bool) {
+		err = driveSvc.Files.Delete(d.Id()).SupportsAllDrives(true).Context(ctx).Do()
+	} else {
+		_, err = driveSvc.Files.Update(d.Id(), &drive.File{Trashed: true}).SupportsAllDrives(true).Context(ctx).Do()
+	}
+	if err != nil {
+		return diag.Errorf("Error deleting folder: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,488 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drivelabels "google.golang.org/api/drivelabels/v2"
+)
+
+func resourceDriveLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDriveLabelCreate,
+		ReadContext:   resourceDriveLabelRead,
+		UpdateContext: resourceDriveLabelUpdate,
+		DeleteContext: resourceDriveLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// SHARED or ADMIN
+			"label_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "SHARED",
+				ForceNew: true,
+			},
+
+			// Fields are matched by display name, renaming a field replaces it.
+			"field": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// text, integer, date, selection or user
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"required": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						// only used by selection fields
+						"choices": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			// publish the label so it can be applied to files, changes to a
+			// published label are published again after they are made
+			"published": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"label_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"revision_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandDriveLabelField(field map[string]interface{}) *drivelabels.GoogleAppsDriveLabelsV2Field {
+	result := &drivelabels.GoogleAppsDriveLabelsV2Field{
+		Properties: &drivelabels.GoogleAppsDriveLabelsV2FieldProperties{
+			DisplayName: field["display_name"].(string),
+			Required:    field["required"].(bool),
+		},
+	}
+
+	switch field["type"].(string) {
+	case "text":
+		result.TextOptions = &drivelabels.GoogleAppsDriveLabelsV2FieldTextOptions{}
+	case "integer":
+		result.IntegerOptions = &drivelabels.GoogleAppsDriveLabelsV2FieldIntegerOptions{}
+	case "date":
+		result.DateOptions = &drivelabels.GoogleAppsDriveLabelsV2FieldDateOptions{
+			DateFormatType: "LONG_DATE",
+		}
+	case "user":
+		result.UserOptions = &drivelabels.GoogleAppsDriveLabelsV2FieldUserOptions{}
+	case "selection":
+		choices := []*drivelabels.GoogleAppsDriveLabelsV2FieldSelectionOptionsChoice{}
+		for _, v := range field["choices"].([]interface{}) {
+			choices = append(choices, expandDriveLabelChoice(v.(string)))
+		}
+		result.SelectionOptions = &drivelabels.GoogleAppsDriveLabelsV2FieldSelectionOptions{
+			Choices: choices,
+		}
+	}
+
+	return result
+}
+
+func expandDriveLabelChoice(displayName string) *drivelabels.GoogleAppsDriveLabelsV2FieldSelectionOptionsChoice {
+	return &drivelabels.GoogleAppsDriveLabelsV2FieldSelectionOptionsChoice{
+		Properties: &drivelabels.GoogleAppsDriveLabelsV2FieldSelectionOptionsChoiceProperties{
+			DisplayName: displayName,
+		},
+	}
+}
+
+func driveLabelFieldType(field *drivelabels.GoogleAppsDriveLabelsV2Field) string {
+	switch {
+	case field.TextOptions != nil:
+		return "text"
+	case field.IntegerOptions != nil:
+		return "integer"
+	case field.DateOptions != nil:
+		return "date"
+	case field.SelectionOptions != nil:
+		return "selection"
+	case field.UserOptions != nil:
+		return "user"
+	}
+	return ""
+}
+
+func isDisabledDriveLabelLifecycle(lifecycle *drivelabels.GoogleAppsDriveLabelsV2Lifecycle) bool {
+	return lifecycle != nil && lifecycle.State == "DISABLED"
+}
+
+func flattenDriveLabelFields(fields []*drivelabels.GoogleAppsDriveLabelsV2Field) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, field := range fields {
+		// Disabled fields are what is left of removed fields on published
+		// labels.
+		if isDisabledDriveLabelLifecycle(field.Lifecycle) {
+			continue
+		}
+
+		choices := []string{}
+		if field.SelectionOptions != nil {
+			for _, choice := range field.SelectionOptions.Choices {
+				if isDisabledDriveLabelLifecycle(choice.Lifecycle) {
+					continue
+				}
+				choices = append(choices, choice.Properties.DisplayName)
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":           field.Id,
+			"display_name": field.Properties.DisplayName,
+			"type":         driveLabelFieldType(field),
+			"required":     field.Properties.Required,
+			"choices":      choices,
+		})
+	}
+	return result
+}
+
+// driveLabelDeltaRequests computes the requests that turn the current label
+// into the configured one.
+func driveLabelDeltaRequests(d *schema.ResourceData, label *drivelabels.GoogleAppsDriveLabelsV2Label) []*drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest {
+	requests := []*drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{}
+	published := label.PublishTime != ""
+
+	if d.HasChange("title") || d.HasChange("description") {
+		requests = append(requests, &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+			UpdateLabel: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestUpdateLabelPropertiesRequest{
+				Properties: &drivelabels.GoogleAppsDriveLabelsV2LabelProperties{
+					Title:       d.Get("title").(string),
+					Description: d.Get("description").(string),
+				},
+				UpdateMask: "title,description",
+			},
+		})
+	}
+
+	current := map[string]*drivelabels.GoogleAppsDriveLabelsV2Field{}
+	for _, field := range label.Fields {
+		if !isDisabledDriveLabelLifecycle(field.Lifecycle) {
+			current[field.Properties.DisplayName] = field
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, v := range d.Get("field").([]interface{}) {
+		field := v.(map[string]interface{})
+		displayName := field["display_name"].(string)
+		desired[displayName] = true
+
+		existing, ok := current[displayName]
+		if !ok || driveLabelFieldType(existing) != field["type"].(string) {
+			if ok {
+				requests = append(requests, removeDriveLabelFieldRequest(existing.Id, published))
+			}
+			log.Printf("[DEBUG] Creating drive label field %s", displayName)
+			requests = append(requests, &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+				CreateField: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestCreateFieldRequest{
+					Field: expandDriveLabelField(field),
+				},
+			})
+			continue
+		}
+
+		if existing.Properties.Required != field["required"].(bool) {
+			log.Printf("[DEBUG] Updating drive label field %s", displayName)
+			requests = append(requests, &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+				UpdateField: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestUpdateFieldPropertiesRequest{
+					Id: existing.Id,
+					Properties: &drivelabels.GoogleAppsDriveLabelsV2FieldProperties{
+						DisplayName: displayName,
+						Required:    field["required"].(bool),
+					},
+					UpdateMask: "required",
+				},
+			})
+		}
+
+		if existing.SelectionOptions != nil {
+			requests = append(requests, driveLabelChoiceRequests(existing, field["choices"].([]interface{}), published)...)
+		}
+	}
+
+	for displayName, field := range current {
+		if !desired[displayName] {
+			log.Printf("[DEBUG] Removing drive label field %s", displayName)
+			requests = append(requests, removeDriveLabelFieldRequest(field.Id, published))
+		}
+	}
+
+	return requests
+}
+
+// removeDriveLabelFieldRequest deletes a field, or disables it when the label
+// has been published since published fields cannot be deleted.
+func removeDriveLabelFieldRequest(id string, published bool) *drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest {
+	if published {
+		return &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+			DisableField: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestDisableFieldRequest{
+				Id:             id,
+				DisabledPolicy: &drivelabels.GoogleAppsDriveLabelsV2LifecycleDisabledPolicy{},
+				UpdateMask:     "*",
+			},
+		}
+	}
+
+	return &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+		DeleteField: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestDeleteFieldRequest{
+			Id: id,
+		},
+	}
+}
+
+func driveLabelChoiceRequests(field *drivelabels.GoogleAppsDriveLabelsV2Field, choices []interface{}, published bool) []*drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest {
+	requests := []*drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{}
+
+	current := map[string]string{}
+	for _, choice := range field.SelectionOptions.Choices {
+		if !isDisabledDriveLabelLifecycle(choice.Lifecycle) {
+			current[choice.Properties.DisplayName] = choice.Id
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, v := range choices {
+		displayName := v.(string)
+		desired[displayName] = true
+		if _, ok := current[displayName]; !ok {
+			requests = append(requests, &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+				CreateSelectionChoice: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestCreateSelectionChoiceRequest{
+					FieldId: field.Id,
+					Choice:  expandDriveLabelChoice(displayName),
+				},
+			})
+		}
+	}
+
+	for displayName, id := range current {
+		if desired[displayName] {
+			continue
+		}
+		if published {
+			requests = append(requests, &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+				DisableSelectionChoice: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestDisableSelectionChoiceRequest{
+					FieldId:        field.Id,
+					Id:             id,
+					DisabledPolicy: &drivelabels.GoogleAppsDriveLabelsV2LifecycleDisabledPolicy{},
+					UpdateMask:     "*",
+				},
+			})
+		} else {
+			requests = append(requests, &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestRequest{
+				DeleteSelectionChoice: &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequestDeleteSelectionChoiceRequest{
+					FieldId: field.Id,
+					Id:      id,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+func resourceDriveLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label := &drivelabels.GoogleAppsDriveLabelsV2Label{
+		LabelType: d.Get("label_type").(string),
+		Properties: &drivelabels.GoogleAppsDriveLabelsV2LabelProperties{
+			Title:       d.Get("title").(string),
+			Description: d.Get("description").(string),
+		},
+	}
+
+	for _, v := range d.Get("field").([]interface{}) {
+		label.Fields = append(label.Fields, expandDriveLabelField(v.(map[string]interface{})))
+	}
+
+	createdLabel, err := driveLabelsSvc.Labels.Create(label).UseAdminAccess(true).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating drive label: %s", err)
+	}
+
+	d.SetId(createdLabel.Name)
+	log.Printf("[INFO] Created drive label: %s", createdLabel.Name)
+
+	if d.Get("published").(bool) {
+		if err := publishDriveLabel(ctx, driveLabelsSvc, createdLabel.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDriveLabelRead(ctx, d, meta)
+}
+
+func publishDriveLabel(ctx context.Context, driveLabelsSvc *drivelabels.Service, name string) error {
+	log.Printf("[DEBUG] Publishing drive label %s", name)
+	_, err := driveLabelsSvc.Labels.Publish(name, &drivelabels.GoogleAppsDriveLabelsV2PublishLabelRequest{
+		UseAdminAccess: true,
+	}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("Error publishing drive label: %s", err)
+	}
+	return nil
+}
+
+func resourceDriveLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label, err := driveLabelsSvc.Labels.Get(d.Id()).UseAdminAccess(true).View("LABEL_VIEW_FULL").Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading drive label: %s", err)
+	}
+
+	requests := driveLabelDeltaRequests(d, label)
+	if len(requests) > 0 {
+		log.Printf("[DEBUG] Applying %d changes to drive label %s", len(requests), d.Id())
+		_, err := driveLabelsSvc.Labels.Delta(d.Id(), &drivelabels.GoogleAppsDriveLabelsV2DeltaUpdateLabelRequest{
+			Requests:       requests,
+			UseAdminAccess: true,
+		}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating drive label: %s", err)
+		}
+	}
+
+	published := d.Get("published").(bool)
+	if published && (len(requests) > 0 || d.HasChange("published")) {
+		if err := publishDriveLabel(ctx, driveLabelsSvc, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if !published && d.HasChange("published") {
+		log.Printf("[DEBUG] Disabling drive label %s", d.Id())
+		_, err := driveLabelsSvc.Labels.Disable(d.Id(), &drivelabels.GoogleAppsDriveLabelsV2DisableLabelRequest{
+			UseAdminAccess: true,
+			DisabledPolicy: &drivelabels.GoogleAppsDriveLabelsV2LifecycleDisabledPolicy{},
+		}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error disabling drive label: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated drive label: %s", d.Id())
+	return resourceDriveLabelRead(ctx, d, meta)
+}
+
+func resourceDriveLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label, err := driveLabelsSvc.Labels.Get(d.Id()).UseAdminAccess(true).View("LABEL_VIEW_FULL").Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("drive label %s", d.Id())))
+	}
+
+	d.Set("label_id", label.Id)
+	d.Set("label_type", label.LabelType)
+	d.Set("revision_id", label.RevisionId)
+	if label.Properties != nil {
+		d.Set("title", label.Properties.Title)
+		d.Set("description", label.Properties.Description)
+	}
+	if label.Lifecycle != nil {
+		d.Set("state", label.Lifecycle.State)
+		d.Set("published", label.Lifecycle.State == "PUBLISHED")
+	}
+	d.Set("field", flattenDriveLabelFields(label.Fields))
+
+	return nil
+}
+
+// resourceDriveLabelDelete disables published labels first, since only
+// draft and disabled labels can be deleted.
+func resourceDriveLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("state").(string) == "PUBLISHED" {
+		log.Printf("[DEBUG] Disabling drive label %s before deleting it", d.Id())
+		_, err := driveLabelsSvc.Labels.Disable(d.Id(), &drivelabels.GoogleAppsDriveLabelsV2DisableLabelRequest{
+			UseAdminAccess: true,
+			DisabledPolicy: &drivelabels.GoogleAppsDriveLabelsV2LifecycleDisabledPolicy{},
+		}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error disabling drive label: %s", err)
+		}
+	}
+
+	_, err = driveLabelsSvc.Labels.Delete(d.Id()).UseAdminAccess(true).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting drive label: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,199 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drivelabels "google.golang.org/api/drivelabels/v2"
+)
+
+func resourceDriveLabelPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDriveLabelPermissionCreate,
+		ReadContext:   resourceDriveLabelPermissionRead,
+		UpdateContext: resourceDriveLabelPermissionUpdate,
+		DeleteContext: resourceDriveLabelPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the label resource name, labels/{id}
+			"label_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// user or group email address
+			"email": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"audience"},
+			},
+
+			// audience resource name, audiences/default for the whole domain
+			"audience": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"email"},
+			},
+
+			// READER, APPLIER, ORGANIZER or EDITOR
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"person": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// driveLabelPermissionParent returns the label a permission name belongs to,
+// permission names have the form labels/{label}/permissions/{permission}.
+func driveLabelPermissionParent(name string) (string, error) {
+	i := strings.Index(name, "/permissions/")
+	if i < 0 {
+		return "", fmt.Errorf("Unexpected drive label permission name %q", name)
+	}
+	return name[:i], nil
+}
+
+// writeDriveLabelPermission creates the permission, or updates it when one
+// already exists for the same principal.
+func writeDriveLabelPermission(ctx context.Context, d *schema.ResourceData, config *Config) (*drivelabels.GoogleAppsDriveLabelsV2LabelPermission, error) {
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Get("email").(string) == "" && d.Get("audience").(string) == "" {
+		return nil, fmt.Errorf("one of email or audience must be set")
+	}
+
+	permission := &drivelabels.GoogleAppsDriveLabelsV2LabelPermission{
+		Email:    d.Get("email").(string),
+		Audience: d.Get("audience").(string),
+		Role:     d.Get("role").(string),
+	}
+
+	return driveLabelsSvc.Labels.Permissions.Create(d.Get("label_id").(string), permission).UseAdminAccess(true).Context(ctx).Do()
+}
+
+func resourceDriveLabelPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	createdPermission, err := writeDriveLabelPermission(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("Error creating drive label permission: %s", err)
+	}
+
+	d.SetId(createdPermission.Name)
+	log.Printf("[INFO] Created drive label permission: %s", createdPermission.Name)
+	return resourceDriveLabelPermissionRead(ctx, d, meta)
+}
+
+func resourceDriveLabelPermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	if d.HasChange("role") {
+		log.Printf("[DEBUG] Updating drive label permission role: %s", d.Get("role").(string))
+		if _, err := writeDriveLabelPermission(ctx, d, config); err != nil {
+			return diag.Errorf("Error updating drive label permission: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated drive label permission: %s", d.Id())
+	return resourceDriveLabelPermissionRead(ctx, d, meta)
+}
+
+func resourceDriveLabelPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	labelID, err := driveLabelPermissionParent(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// There is no call to get a single permission, so page through the
+	// permissions of the label.
+	var permission *drivelabels.GoogleAppsDriveLabelsV2LabelPermission
+	pageToken := ""
+	for permission == nil {
+		call := driveLabelsSvc.Labels.Permissions.List(labelID).UseAdminAccess(true)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("drive label permission %s", d.Id())))
+		}
+
+		for _, p := range resp.LabelPermissions {
+			if p.Name == d.Id() {
+				permission = p
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if permission == nil {
+		log.Printf("[WARN] Removing drive label permission %s because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("label_id", labelID)
+	d.Set("role", permission.Role)
+	d.Set("audience", permission.Audience)
+	d.Set("person", permission.Person)
+	d.Set("group", permission.Group)
+	if permission.Email != "" {
+		d.Set("email", permission.Email)
+	}
+
+	return nil
+}
+
+func resourceDriveLabelPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveLabelsSvc, err := config.driveLabelsService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = driveLabelsSvc.Labels.Permissions.Delete(d.Id()).UseAdminAccess(true).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting drive label permission: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,228 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drive "google.golang.org/api/drive/v3"
+)
+
+func resourceDrivePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDrivePermissionCreate,
+		ReadContext:   resourceDrivePermissionRead,
+		UpdateContext: resourceDrivePermissionUpdate,
+		DeleteContext: resourceDrivePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the user to act as instead of the provider's
+			// impersonated_user_email
+			"impersonated_user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// ID of the file or folder
+			"file_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// user, group, domain or anyone
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// user or group email address
+			"email_address": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"domain"},
+			},
+
+			"domain": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"email_address"},
+			},
+
+			// owner, organizer, fileOrganizer, writer, commenter or reader
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// only applies to domain and anyone permissions
+			"allow_file_discovery": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			// RFC3339 timestamp after which the permission is removed
+			"expiration_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"send_notification_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"permission_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDrivePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fileID := d.Get("file_id").(string)
+	permission := &drive.Permission{
+		Type:           d.Get("type").(string),
+		Role:           d.Get("role").(string),
+		EmailAddress:   d.Get("email_address").(string),
+		Domain:         d.Get("domain").(string),
+		ExpirationTime: d.Get("expiration_time").(string),
+	}
+
+	if permission.Type == "domain" || permission.Type == "anyone" {
+		permission.AllowFileDiscovery = d.Get("allow_file_discovery").(bool)
+		permission.ForceSendFields = []string{"AllowFileDiscovery"}
+	}
+
+	call := driveSvc.Permissions.Create(fileID, permission).SupportsAllDrives(true)
+	// Notifications can only be controlled for users and groups
+	if permission.Type == "user" || permission.Type == "group" {
+		call = call.SendNotificationEmail(d.Get("send_notification_email").(bool))
+	}
+	if permission.Role == "owner" {
+		call = call.TransferOwnership(true)
+	}
+
+	createdPermission, err := call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating drive permission: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", fileID, createdPermission.Id))
+	log.Printf("[INFO] Created drive permission %s on %s", createdPermission.Id, fileID)
+	return resourceDrivePermissionRead(ctx, d, meta)
+}
+
+func resourceDrivePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission := &drive.Permission{}
+	call := driveSvc.Permissions.Update(d.Get("file_id").(string), d.Get("permission_id").(string), permission).SupportsAllDrives(true)
+
+	if d.HasChange("role") {
+		log.Printf("[DEBUG] Updating drive permission role: %s", d.Get("role").(string))
+		permission.Role = d.Get("role").(string)
+		if permission.Role == "owner" {
+			call = call.TransferOwnership(true)
+		}
+	}
+	if d.HasChange("expiration_time") {
+		if v, ok := d.GetOk("expiration_time"); ok {
+			log.Printf("[DEBUG] Updating drive permission expiration_time: %s", v.(string))
+			permission.ExpirationTime = v.(string)
+		} else {
+			log.Printf("[DEBUG] Removing drive permission expiration_time")
+			call = call.RemoveExpiration(true)
+		}
+	}
+
+	_, err = call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating drive permission: %s", err)
+	}
+
+	log.Printf("[INFO] Updated drive permission: %s", d.Id())
+	return resourceDrivePermissionRead(ctx, d, meta)
+}
+
+func resourceDrivePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fileID, permissionID := parts[0], parts[1]
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := driveSvc.Permissions.Get(fileID, permissionID).SupportsAllDrives(true).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("drive permission %s", d.Id())))
+	}
+
+	d.Set("file_id", fileID)
+	d.Set("permission_id", permission.Id)
+	d.Set("type", permission.Type)
+	d.Set("role", permission.Role)
+	d.Set("email_address", permission.EmailAddress)
+	d.Set("domain", permission.Domain)
+	d.Set("allow_file_discovery", permission.AllowFileDiscovery)
+	d.Set("expiration_time", permission.ExpirationTime)
+	d.Set("display_name", permission.DisplayName)
+
+	return nil
+}
+
+func resourceDrivePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = driveSvc.Permissions.Delete(d.Get("file_id").(string), d.Get("permission_id").(string)).SupportsAllDrives(true).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting drive permission: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
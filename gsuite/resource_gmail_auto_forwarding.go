@@ -0,0 +1,127 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailAutoForwarding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailAutoForwardingCreate,
+		ReadContext:   resourceGmailAutoForwardingRead,
+		UpdateContext: resourceGmailAutoForwardingUpdate,
+		DeleteContext: resourceGmailAutoForwardingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// must be a verified gsuite_gmail_forwarding_address
+			"email_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// leaveInInbox, archive, trash or markRead
+			"disposition": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "leaveInInbox",
+			},
+		},
+	}
+}
+
+func resourceGmailAutoForwardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("user_id").(string))
+	return resourceGmailAutoForwardingUpdate(ctx, d, meta)
+}
+
+func resourceGmailAutoForwardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	autoForwarding := &gmail.AutoForwarding{
+		Enabled:         d.Get("enabled").(bool),
+		EmailAddress:    d.Get("email_address").(string),
+		Disposition:     d.Get("disposition").(string),
+		ForceSendFields: []string{"Enabled"},
+	}
+
+	log.Printf("[DEBUG] Updating auto-forwarding for %s: %t to %s (%s)", userID, autoForwarding.Enabled, autoForwarding.EmailAddress, autoForwarding.Disposition)
+	_, err = gmailSvc.Users.Settings.UpdateAutoForwarding(userID, autoForwarding).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating auto-forwarding: %s", err)
+	}
+
+	log.Printf("[INFO] Updated auto-forwarding for %s", userID)
+	return resourceGmailAutoForwardingRead(ctx, d, meta)
+}
+
+func resourceGmailAutoForwardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	autoForwarding, err := gmailSvc.Users.Settings.GetAutoForwarding(userID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("auto-forwarding for %s", userID)))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("enabled", autoForwarding.Enabled)
+	d.Set("email_address", autoForwarding.EmailAddress)
+	d.Set("disposition", autoForwarding.Disposition)
+
+	return nil
+}
+
+func resourceGmailAutoForwardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	autoForwarding := &gmail.AutoForwarding{
+		Enabled:         false,
+		ForceSendFields: []string{"Enabled"},
+	}
+
+	_, err = gmailSvc.Users.Settings.UpdateAutoForwarding(userID, autoForwarding).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error disabling auto-forwarding: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,121 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailDelegate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailDelegateCreate,
+		ReadContext:   resourceGmailDelegateRead,
+		DeleteContext: resourceGmailDelegateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"delegate_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// accepted, pending, rejected or expired
+			"verification_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGmailDelegateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	delegate := &gmail.Delegate{
+		DelegateEmail: d.Get("delegate_email").(string),
+	}
+
+	createdDelegate, err := gmailSvc.Users.Settings.Delegates.Create(userID, delegate).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating delegate: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userID, createdDelegate.DelegateEmail))
+	log.Printf("[INFO] Created delegate %s for %s (%s)", createdDelegate.DelegateEmail, userID, createdDelegate.VerificationStatus)
+	return resourceGmailDelegateRead(ctx, d, meta)
+}
+
+func resourceGmailDelegateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userID, delegateEmail := parts[0], parts[1]
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	delegate, err := gmailSvc.Users.Settings.Delegates.Get(userID, delegateEmail).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("delegate %s", d.Id())))
+	}
+
+	// A delegate that was rejected or never accepted in time has to be invited
+	// again, so let terraform recreate it.
+	switch delegate.VerificationStatus {
+	case "rejected", "expired":
+		log.Printf("[WARN] Delegate %s is %s, removing from state", d.Id(), delegate.VerificationStatus)
+		d.SetId("")
+		return nil
+	case "pending":
+		log.Printf("[INFO] Delegate %s has not accepted the invitation yet", d.Id())
+	}
+
+	d.Set("user_id", userID)
+	d.Set("delegate_email", delegate.DelegateEmail)
+	d.Set("verification_status", delegate.VerificationStatus)
+
+	return nil
+}
+
+func resourceGmailDelegateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = gmailSvc.Users.Settings.Delegates.Delete(userID, d.Get("delegate_email").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting delegate: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
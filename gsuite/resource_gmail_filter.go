@@ -0,0 +1,249 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailFilterCreate,
+		ReadContext:   resourceGmailFilterRead,
+		DeleteContext: resourceGmailFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		// Filters cannot be updated through the API, every change recreates
+		// the filter.
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"filter_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"criteria": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"to": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"subject": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"query": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"negated_query": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"has_attachment": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"exclude_chats": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						// larger or smaller
+						"size_comparison": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"action": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_label_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						// use "INBOX" to archive matching messages
+						"remove_label_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"forward": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandGmailFilterCriteria(d *schema.ResourceData) *gmail.FilterCriteria {
+	prefix := "criteria.0"
+	return &gmail.FilterCriteria{
+		From:           d.Get(prefix + ".from").(string),
+		To:             d.Get(prefix + ".to").(string),
+		Subject:        d.Get(prefix + ".subject").(string),
+		Query:          d.Get(prefix + ".query").(string),
+		NegatedQuery:   d.Get(prefix + ".negated_query").(string),
+		HasAttachment:  d.Get(prefix + ".has_attachment").(bool),
+		ExcludeChats:   d.Get(prefix + ".exclude_chats").(bool),
+		Size:           int64(d.Get(prefix + ".size").(int)),
+		SizeComparison: d.Get(prefix + ".size_comparison").(string),
+	}
+}
+
+func expandGmailFilterAction(d *schema.ResourceData) *gmail.FilterAction {
+	prefix := "action.0"
+	return &gmail.FilterAction{
+		AddLabelIds:    convertStringList(d.Get(prefix + ".add_label_ids").([]interface{})),
+		RemoveLabelIds: convertStringList(d.Get(prefix + ".remove_label_ids").([]interface{})),
+		Forward:        d.Get(prefix + ".forward").(string),
+	}
+}
+
+func flattenGmailFilterCriteria(criteria *gmail.FilterCriteria) []map[string]interface{} {
+	if criteria == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"from":            criteria.From,
+			"to":              criteria.To,
+			"subject":         criteria.Subject,
+			"query":           criteria.Query,
+			"negated_query":   criteria.NegatedQuery,
+			"has_attachment":  criteria.HasAttachment,
+			"exclude_chats":   criteria.ExcludeChats,
+			"size":            criteria.Size,
+			"size_comparison": criteria.SizeComparison,
+		},
+	}
+}
+
+func flattenGmailFilterAction(action *gmail.FilterAction) []map[string]interface{} {
+	if action == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"add_label_ids":    action.AddLabelIds,
+			"remove_label_ids": action.RemoveLabelIds,
+			"forward":          action.Forward,
+		},
+	}
+}
+
+func resourceGmailFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := &gmail.Filter{
+		Criteria: expandGmailFilterCriteria(d),
+		Action:   expandGmailFilterAction(d),
+	}
+
+	createdFilter, err := gmailSvc.Users.Settings.Filters.Create(userID, filter).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating filter: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userID, createdFilter.Id))
+	log.Printf("[INFO] Created filter %s for %s", createdFilter.Id, userID)
+	return resourceGmailFilterRead(ctx, d, meta)
+}
+
+func resourceGmailFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userID, filterID := parts[0], parts[1]
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter, err := gmailSvc.Users.Settings.Filters.Get(userID, filterID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("filter %s", d.Id())))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("filter_id", filter.Id)
+	d.Set("criteria", flattenGmailFilterCriteria(filter.Criteria))
+	d.Set("action", flattenGmailFilterAction(filter.Action))
+
+	return nil
+}
+
+func resourceGmailFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = gmailSvc.Users.Settings.Filters.Delete(userID, d.Get("filter_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting filter: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
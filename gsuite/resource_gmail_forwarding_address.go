@@ -0,0 +1,111 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailForwardingAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailForwardingAddressCreate,
+		ReadContext:   resourceGmailForwardingAddressRead,
+		DeleteContext: resourceGmailForwardingAddressDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"forwarding_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// accepted or pending, addresses outside of the domain have to be
+			// confirmed by their owner before they can be used
+			"verification_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGmailForwardingAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	forwardingAddress := &gmail.ForwardingAddress{
+		ForwardingEmail: d.Get("forwarding_email").(string),
+	}
+
+	createdForwardingAddress, err := gmailSvc.Users.Settings.ForwardingAddresses.Create(userID, forwardingAddress).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating forwarding address: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userID, createdForwardingAddress.ForwardingEmail))
+	log.Printf("[INFO] Created forwarding address %s for %s", createdForwardingAddress.ForwardingEmail, userID)
+	return resourceGmailForwardingAddressRead(ctx, d, meta)
+}
+
+func resourceGmailForwardingAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userID, forwardingEmail := parts[0], parts[1]
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	forwardingAddress, err := gmailSvc.Users.Settings.ForwardingAddresses.Get(userID, forwardingEmail).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("forwarding address %s", d.Id())))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("forwarding_email", forwardingAddress.ForwardingEmail)
+	d.Set("verification_status", forwardingAddress.VerificationStatus)
+
+	return nil
+}
+
+func resourceGmailForwardingAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = gmailSvc.Users.Settings.ForwardingAddresses.Delete(userID, d.Get("forwarding_email").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting forwarding address: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
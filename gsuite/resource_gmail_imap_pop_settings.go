@@ -0,0 +1,154 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailImapPopSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailImapPopSettingsCreate,
+		ReadContext:   resourceGmailImapPopSettingsRead,
+		UpdateContext: resourceGmailImapPopSettingsUpdate,
+		DeleteContext: resourceGmailImapPopSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"imap_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"imap_auto_expunge": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// archive, trash or deleteForever
+			"imap_expunge_behavior": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// 0 means no limit
+			"imap_max_folder_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			// disabled, allMail or fromNowOn
+			"pop_access_window": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// leaveInInbox, archive, trash or markRead
+			"pop_disposition": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGmailImapPopSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("user_id").(string))
+	return resourceGmailImapPopSettingsUpdate(ctx, d, meta)
+}
+
+func resourceGmailImapPopSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.IsNewResource() || d.HasChange("imap_enabled") || d.HasChange("imap_auto_expunge") ||
+		d.HasChange("imap_expunge_behavior") || d.HasChange("imap_max_folder_size") {
+		imap := &gmail.ImapSettings{
+			Enabled:         d.Get("imap_enabled").(bool),
+			AutoExpunge:     d.Get("imap_auto_expunge").(bool),
+			ExpungeBehavior: d.Get("imap_expunge_behavior").(string),
+			MaxFolderSize:   int64(d.Get("imap_max_folder_size").(int)),
+			ForceSendFields: []string{"Enabled", "AutoExpunge", "MaxFolderSize"},
+		}
+
+		log.Printf("[DEBUG] Updating IMAP settings for %s: %t", userID, imap.Enabled)
+		if _, err := gmailSvc.Users.Settings.UpdateImap(userID, imap).Context(ctx).Do(); err != nil {
+			return diag.Errorf("Error updating IMAP settings: %s", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("pop_access_window") || d.HasChange("pop_disposition") {
+		pop := &gmail.PopSettings{
+			AccessWindow: d.Get("pop_access_window").(string),
+			Disposition:  d.Get("pop_disposition").(string),
+		}
+
+		log.Printf("[DEBUG] Updating POP settings for %s: %s", userID, pop.AccessWindow)
+		if _, err := gmailSvc.Users.Settings.UpdatePop(userID, pop).Context(ctx).Do(); err != nil {
+			return diag.Errorf("Error updating POP settings: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated IMAP/POP settings for %s", userID)
+	return resourceGmailImapPopSettingsRead(ctx, d, meta)
+}
+
+func resourceGmailImapPopSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imap, err := gmailSvc.Users.Settings.GetImap(userID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("IMAP settings for %s", userID)))
+	}
+
+	pop, err := gmailSvc.Users.Settings.GetPop(userID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("POP settings for %s", userID)))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("imap_enabled", imap.Enabled)
+	d.Set("imap_auto_expunge", imap.AutoExpunge)
+	d.Set("imap_expunge_behavior", imap.ExpungeBehavior)
+	d.Set("imap_max_folder_size", imap.MaxFolderSize)
+	d.Set("pop_access_window", pop.AccessWindow)
+	d.Set("pop_disposition", pop.Disposition)
+
+	return nil
+}
+
+// resourceGmailImapPopSettingsDelete only removes the settings from state, the
+// mailbox keeps whatever was last applied.
+func resourceGmailImapPopSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] IMAP/POP settings for %s are left as they are", d.Id())
+	d.SetId("")
+	return nil
+}
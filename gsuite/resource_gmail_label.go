@@ -0,0 +1,191 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailLabelCreate,
+		ReadContext:   resourceGmailLabelRead,
+		UpdateContext: resourceGmailLabelUpdate,
+		DeleteContext: resourceGmailLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"label_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// nested labels use "/" as separator, e.g. "Customers/Acme"
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// labelShow, labelShowIfUnread or labelHide
+			"label_list_visibility": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "labelShow",
+			},
+
+			// show or hide
+			"message_list_visibility": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "show",
+			},
+
+			"background_color": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"text_color": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandGmailLabel(d *schema.ResourceData) *gmail.Label {
+	label := &gmail.Label{
+		Name:                  d.Get("name").(string),
+		LabelListVisibility:   d.Get("label_list_visibility").(string),
+		MessageListVisibility: d.Get("message_list_visibility").(string),
+	}
+
+	backgroundColor := d.Get("background_color").(string)
+	textColor := d.Get("text_color").(string)
+	if backgroundColor != "" || textColor != "" {
+		label.Color = &gmail.LabelColor{
+			BackgroundColor: backgroundColor,
+			TextColor:       textColor,
+		}
+	}
+
+	return label
+}
+
+func resourceGmailLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdLabel, err := gmailSvc.Users.Labels.Create(userID, expandGmailLabel(d)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating label: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userID, createdLabel.Id))
+	log.Printf("[INFO] Created label %s for %s", createdLabel.Name, userID)
+	return resourceGmailLabelRead(ctx, d, meta)
+}
+
+func resourceGmailLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label := expandGmailLabel(d)
+	if label.Color == nil && (d.HasChange("background_color") || d.HasChange("text_color")) {
+		label.NullFields = append(label.NullFields, "Color")
+	}
+	if d.HasChange("name") {
+		old, _ := d.GetChange("name")
+		log.Printf("[DEBUG] Renaming label %s to %s", old.(string), label.Name)
+	}
+
+	updatedLabel, err := gmailSvc.Users.Labels.Patch(userID, d.Get("label_id").(string), label).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating label: %s", err)
+	}
+
+	log.Printf("[INFO] Updated label %s for %s", updatedLabel.Name, userID)
+	return resourceGmailLabelRead(ctx, d, meta)
+}
+
+func resourceGmailLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userID, labelID := parts[0], parts[1]
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	label, err := gmailSvc.Users.Labels.Get(userID, labelID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("label %s", d.Id())))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("label_id", label.Id)
+	d.Set("name", label.Name)
+	d.Set("label_list_visibility", label.LabelListVisibility)
+	d.Set("message_list_visibility", label.MessageListVisibility)
+	d.Set("type", label.Type)
+	if label.Color != nil {
+		d.Set("background_color", label.Color.BackgroundColor)
+		d.Set("text_color", label.Color.TextColor)
+	} else {
+		d.Set("background_color", "")
+		d.Set("text_color", "")
+	}
+
+	return nil
+}
+
+func resourceGmailLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = gmailSvc.Users.Labels.Delete(userID, d.Get("label_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting label: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
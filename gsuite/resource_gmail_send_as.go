@@ -0,0 +1,243 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailSendAs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailSendAsCreate,
+		ReadContext:   resourceGmailSendAsRead,
+		UpdateContext: resourceGmailSendAsUpdate,
+		DeleteContext: resourceGmailSendAsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"send_as_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"reply_to_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"signature": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"treat_as_alias": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_primary": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"verification_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// isPrimarySendAs reports whether the send-as address is the mailbox's own
+// primary address, which always exists and can only be patched.
+func isPrimarySendAs(userID, sendAsEmail string) bool {
+	return strings.EqualFold(userID, sendAsEmail)
+}
+
+func resourceGmailSendAsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sendAs := &gmail.SendAs{
+		SendAsEmail: d.Get("send_as_email").(string),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		log.Printf("[DEBUG] Setting send-as display_name: %s", v.(string))
+		sendAs.DisplayName = v.(string)
+	}
+	if v, ok := d.GetOk("reply_to_address"); ok {
+		log.Printf("[DEBUG] Setting send-as reply_to_address: %s", v.(string))
+		sendAs.ReplyToAddress = v.(string)
+	}
+	if v, ok := d.GetOk("signature"); ok {
+		log.Printf("[DEBUG] Setting send-as signature")
+		sendAs.Signature = v.(string)
+	}
+	if v, ok := d.GetOkExists("treat_as_alias"); ok {
+		log.Printf("[DEBUG] Setting send-as treat_as_alias: %t", v.(bool))
+		sendAs.TreatAsAlias = v.(bool)
+		sendAs.ForceSendFields = append(sendAs.ForceSendFields, "TreatAsAlias")
+	}
+	if v, ok := d.GetOkExists("is_default"); ok {
+		log.Printf("[DEBUG] Setting send-as is_default: %t", v.(bool))
+		sendAs.IsDefault = v.(bool)
+		sendAs.ForceSendFields = append(sendAs.ForceSendFields, "IsDefault")
+	}
+
+	var createdSendAs *gmail.SendAs
+	if isPrimarySendAs(userID, sendAs.SendAsEmail) {
+		log.Printf("[DEBUG] %s is the primary address, patching instead of creating", sendAs.SendAsEmail)
+		createdSendAs, err = gmailSvc.Users.Settings.SendAs.Patch(userID, sendAs.SendAsEmail, sendAs).Context(ctx).Do()
+	} else {
+		createdSendAs, err = gmailSvc.Users.Settings.SendAs.Create(userID, sendAs).Context(ctx).Do()
+	}
+	if err != nil {
+		return diag.Errorf("Error creating send-as: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userID, createdSendAs.SendAsEmail))
+	log.Printf("[INFO] Created send-as: %s", createdSendAs.SendAsEmail)
+	return resourceGmailSendAsRead(ctx, d, meta)
+}
+
+func resourceGmailSendAsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sendAs := &gmail.SendAs{}
+	forceSendFields := []string{}
+
+	if d.HasChange("display_name") {
+		log.Printf("[DEBUG] Updating send-as display_name: %s", d.Get("display_name").(string))
+		sendAs.DisplayName = d.Get("display_name").(string)
+		forceSendFields = append(forceSendFields, "DisplayName")
+	}
+	if d.HasChange("reply_to_address") {
+		log.Printf("[DEBUG] Updating send-as reply_to_address: %s", d.Get("reply_to_address").(string))
+		sendAs.ReplyToAddress = d.Get("reply_to_address").(string)
+		forceSendFields = append(forceSendFields, "ReplyToAddress")
+	}
+	if d.HasChange("signature") {
+		log.Printf("[DEBUG] Updating send-as signature")
+		sendAs.Signature = d.Get("signature").(string)
+		forceSendFields = append(forceSendFields, "Signature")
+	}
+	if d.HasChange("treat_as_alias") {
+		log.Printf("[DEBUG] Updating send-as treat_as_alias: %t", d.Get("treat_as_alias").(bool))
+		sendAs.TreatAsAlias = d.Get("treat_as_alias").(bool)
+		forceSendFields = append(forceSendFields, "TreatAsAlias")
+	}
+	if d.HasChange("is_default") {
+		log.Printf("[DEBUG] Updating send-as is_default: %t", d.Get("is_default").(bool))
+		sendAs.IsDefault = d.Get("is_default").(bool)
+		forceSendFields = append(forceSendFields, "IsDefault")
+	}
+
+	if len(forceSendFields) > 0 {
+		sendAs.ForceSendFields = forceSendFields
+	}
+
+	updatedSendAs, err := gmailSvc.Users.Settings.SendAs.Patch(userID, d.Get("send_as_email").(string), sendAs).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating send-as: %s", err)
+	}
+
+	log.Printf("[INFO] Updated send-as: %s", updatedSendAs.SendAsEmail)
+	return resourceGmailSendAsRead(ctx, d, meta)
+}
+
+func resourceGmailSendAsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userID, sendAsEmail := parts[0], parts[1]
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sendAs, err := gmailSvc.Users.Settings.SendAs.Get(userID, sendAsEmail).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("send-as %s", d.Id())))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("send_as_email", sendAs.SendAsEmail)
+	d.Set("display_name", sendAs.DisplayName)
+	d.Set("reply_to_address", sendAs.ReplyToAddress)
+	d.Set("signature", sendAs.Signature)
+	d.Set("treat_as_alias", sendAs.TreatAsAlias)
+	d.Set("is_default", sendAs.IsDefault)
+	d.Set("is_primary", sendAs.IsPrimary)
+	d.Set("verification_status", sendAs.VerificationStatus)
+
+	return nil
+}
+
+func resourceGmailSendAsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	sendAsEmail := d.Get("send_as_email").(string)
+
+	if isPrimarySendAs(userID, sendAsEmail) {
+		log.Printf("[WARN] %s is the primary address and cannot be deleted, removing from state only", sendAsEmail)
+		d.SetId("")
+		return nil
+	}
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = gmailSvc.Users.Settings.SendAs.Delete(userID, sendAsEmail).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting send-as: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
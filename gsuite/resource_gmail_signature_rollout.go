@@ -0,0 +1,427 @@
+package gsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	directory "google.golang.org/api/admin/directory/v1"
+	gmail "google.golang.org/api/gmail/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// resourceGmailSignatureRollout applies a templated signature to the primary
+// send-as address of every user in an org unit or group. Every read checks
+// the current members, so users joining later get the signature on the next
+// apply, and users leaving have it cleared.
+func resourceGmailSignatureRollout() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailSignatureRolloutCreate,
+		ReadContext:   resourceGmailSignatureRolloutRead,
+		UpdateContext: resourceGmailSignatureRolloutUpdate,
+		DeleteContext: resourceGmailSignatureRolloutDelete,
+
+		CustomizeDiff: resourceGmailSignatureRolloutCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// the customer to act on instead of the provider's customer_id
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"org_unit_path": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group_email"},
+			},
+
+			"group_email": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"org_unit_path"},
+			},
+
+			// Supports {{full_name}}, {{given_name}}, {{family_name}},
+			// {{email}}, {{title}}, {{department}} and {{phone}}.
+			"signature_template": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"clear_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"in_sync": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceGmailSignatureRolloutCustomizeDiff forces an update whenever the
+// last read found users whose signature does not match the template.
+func resourceGmailSignatureRolloutCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.Get("in_sync").(bool) {
+		log.Printf("[DEBUG] Signature rollout %s is out of sync", d.Id())
+		return d.SetNew("in_sync", true)
+	}
+	return nil
+}
+
+// signatureRolloutTargets returns the active users the signature applies to.
+func signatureRolloutTargets(ctx context.Context, config *Config, d *schema.ResourceData) ([]*directory.User, error) {
+	if v, ok := d.GetOk("org_unit_path"); ok {
+		query := fmt.Sprintf("orgUnitPath='%s' isSuspended=false", escapeQueryValue(v.(string)))
+		return listUsers(ctx, config, customerID(d, config), query)
+	}
+
+	if v, ok := d.GetOk("group_email"); ok {
+		return listGroupUsers(ctx, config, customerID(d, config), v.(string))
+	}
+
+	return nil, fmt.Errorf("one of org_unit_path or group_email must be set")
+}
+
+// escapeQueryValue escapes a value for a quoted string of a user search
+// query.
+func escapeQueryValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v)
+}
+
+// listUsers pages through all users of the customer matching the query.
+func listUsers(ctx context.Context, config *Config, customerID, query string) ([]*directory.User, error) {
+	var users []*directory.User
+	pageToken := ""
+	for {
+		call := config.directory.Users.List().Customer(customerID).Projection("full").Query(query)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing users: %s", err)
+		}
+
+		users = append(users, resp.Users...)
+		if resp.NextPageToken == "" {
+			return users, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// listGroupUsers returns the active users that are direct members of a group.
+// Members only carry their ID and email, so the attributes of the templates
+// come from a single listing of the customer's users.
+func listGroupUsers(ctx context.Context, config *Config, customerID, groupKey string) ([]*directory.User, error) {
+	var members []*directory.Member
+	pageToken := ""
+	for {
+		call := config.directory.Members.List(groupKey)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing members of %s: %s", groupKey, err)
+		}
+
+		for _, member := range resp.Members {
+			if member.Type == "USER" && member.Status != "SUSPENDED" {
+				members = append(members, member)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	all, err := listUsers(ctx, config, customerID, "isSuspended=false")
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*directory.User, len(all))
+	for _, user := range all {
+		byID[user.Id] = user
+	}
+
+	users := make([]*directory.User, 0, len(members))
+	for _, member := range members {
+		// members outside of the customer have no mailbox of it
+		if user, ok := byID[member.Id]; ok {
+			users = append(users, user)
+		} else {
+			log.Printf("[DEBUG] Skipping %s, who is not an active user of %s", member.Email, customerID)
+		}
+	}
+	return users, nil
+}
+
+// renderSignature fills in the template placeholders with the user's
+// directory attributes, escaped for the HTML of the signature.
+func renderSignature(template string, user *directory.User) string {
+	var fullName, givenName, familyName, title, department, phone string
+	if user.Name != nil {
+		fullName = user.Name.FullName
+		givenName = user.Name.GivenName
+		familyName = user.Name.FamilyName
+	}
+
+	// Organizations and phones are untyped in the directory client, so
+	// round-trip them through JSON.
+	var organizations []*directory.UserOrganization
+	if b, err := json.Marshal(user.Organizations); err == nil {
+		json.Unmarshal(b, &organizations)
+	}
+	for _, organization := range organizations {
+		if organization.Primary || title == "" {
+			title = organization.Title
+			department = organization.Department
+		}
+	}
+
+	var phones []*directory.UserPhone
+	if b, err := json.Marshal(user.Phones); err == nil {
+		json.Unmarshal(b, &phones)
+	}
+	for _, p := range phones {
+		if p.Primary || phone == "" {
+			phone = p.Value
+		}
+	}
+
+	return strings.NewReplacer(
+		"{{full_name}}", html.EscapeString(fullName),
+		"{{given_name}}", html.EscapeString(givenName),
+		"{{family_name}}", html.EscapeString(familyName),
+		"{{email}}", html.EscapeString(user.PrimaryEmail),
+		"{{title}}", html.EscapeString(title),
+		"{{department}}", html.EscapeString(department),
+		"{{phone}}", html.EscapeString(phone),
+	).Replace(template)
+}
+
+// normalizeSignature returns the signature HTML as rendered by an HTML
+// parser. Gmail rewrites the HTML of signatures it stores, e.g. closing tags
+// and re-escaping entities, so signatures are only compared once both went
+// through the same rewrite.
+func normalizeSignature(signature string) string {
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(signature), body)
+	if err != nil {
+		return strings.TrimSpace(signature)
+	}
+
+	var b strings.Builder
+	for _, node := range nodes {
+		if err := html.Render(&b, node); err != nil {
+			return strings.TrimSpace(signature)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// signaturesEqual returns whether a signature read from Gmail is the rendered
+// template.
+func signaturesEqual(current, signature string) bool {
+	return current == signature || normalizeSignature(current) == normalizeSignature(signature)
+}
+
+// isMailServiceNotEnabledError returns whether the error is the one Gmail
+// returns for users who have no Gmail, e.g. unlicensed ones.
+func isMailServiceNotEnabledError(err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	return ok && gerr.Code == 400 && strings.Contains(strings.ToLower(gerr.Message), "mail service not enabled")
+}
+
+// readSignature returns the signature of the user's primary send-as address.
+// Users without Gmail have none to read or set, so false is returned for them
+// instead of an error.
+func readSignature(ctx context.Context, config *Config, email string) (string, bool, error) {
+	gmailSvc, err := config.gmailService(email)
+	if err != nil {
+		return "", false, err
+	}
+
+	sendAs, err := gmailSvc.Users.Settings.SendAs.Get(email, email).Context(ctx).Do()
+	if err != nil {
+		if isMailServiceNotEnabledError(err) {
+			log.Printf("[WARN] Skipping %s, who has no Gmail: %s", email, err)
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("Error reading signature for %s: %s", email, err)
+	}
+	return sendAs.Signature, true, nil
+}
+
+// applySignature sets the signature of the user's primary send-as address.
+func applySignature(ctx context.Context, config *Config, email, signature string) error {
+	gmailSvc, err := config.gmailService(email)
+	if err != nil {
+		return err
+	}
+
+	sendAs := &gmail.SendAs{
+		Signature:       signature,
+		ForceSendFields: []string{"Signature"},
+	}
+
+	_, err = gmailSvc.Users.Settings.SendAs.Patch(email, email, sendAs).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("Error updating signature for %s: %s", email, err)
+	}
+	return nil
+}
+
+func resourceGmailSignatureRolloutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if v, ok := d.GetOk("org_unit_path"); ok {
+		d.SetId(fmt.Sprintf("orgunit:%s", v.(string)))
+	} else {
+		d.SetId(fmt.Sprintf("group:%s", d.Get("group_email").(string)))
+	}
+
+	return resourceGmailSignatureRolloutUpdate(ctx, d, meta)
+}
+
+func resourceGmailSignatureRolloutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	users, err := signatureRolloutTargets(ctx, config, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template := d.Get("signature_template").(string)
+	targets := map[string]bool{}
+	emails := make([]string, 0, len(users))
+	for _, user := range users {
+		targets[user.PrimaryEmail] = true
+		emails = append(emails, user.PrimaryEmail)
+
+		current, ok, err := readSignature(ctx, config, user.PrimaryEmail)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		signature := renderSignature(template, user)
+		if !ok || signaturesEqual(current, signature) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Updating signature for %s", user.PrimaryEmail)
+		if err := applySignature(ctx, config, user.PrimaryEmail, signature); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// users only left in the state have left the org unit or group
+	for _, v := range d.Get("users").([]interface{}) {
+		email := v.(string)
+		if targets[email] {
+			continue
+		}
+
+		_, ok, err := readSignature(ctx, config, email)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Clearing signature for %s, who left %s", email, d.Id())
+		if err := applySignature(ctx, config, email, ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	sort.Strings(emails)
+	d.Set("users", emails)
+
+	log.Printf("[INFO] Rolled out signature to %d users for %s", len(users), d.Id())
+	return resourceGmailSignatureRolloutRead(ctx, d, meta)
+}
+
+func resourceGmailSignatureRolloutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	users, err := signatureRolloutTargets(ctx, config, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template := d.Get("signature_template").(string)
+	targets := map[string]bool{}
+	emails := make([]string, 0, len(users))
+	inSync := true
+	for _, user := range users {
+		targets[user.PrimaryEmail] = true
+		emails = append(emails, user.PrimaryEmail)
+
+		signature, ok, err := readSignature(ctx, config, user.PrimaryEmail)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ok && !signaturesEqual(signature, renderSignature(template, user)) {
+			log.Printf("[DEBUG] Signature for %s does not match the template", user.PrimaryEmail)
+			inSync = false
+		}
+	}
+
+	// Users who left are kept until the next apply clears their signature
+	for _, v := range d.Get("users").([]interface{}) {
+		if email := v.(string); !targets[email] {
+			log.Printf("[DEBUG] %s left %s", email, d.Id())
+			emails = append(emails, email)
+			inSync = false
+		}
+	}
+	sort.Strings(emails)
+
+	d.Set("users", emails)
+	d.Set("in_sync", inSync)
+
+	return nil
+}
+
+func resourceGmailSignatureRolloutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	if d.Get("clear_on_destroy").(bool) {
+		for _, v := range d.Get("users").([]interface{}) {
+			log.Printf("[DEBUG] Clearing signature for %s", v.(string))
+			if err := applySignature(ctx, config, v.(string), ""); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
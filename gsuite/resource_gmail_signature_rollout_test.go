@@ -0,0 +1,144 @@
+package gsuite
+
+import (
+	"fmt"
+	"testing"
+
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+)
+
+func TestRenderSignature(t *testing.T) {
+	template := "{{full_name}} | {{given_name}} {{family_name}} | {{email}} | {{title}}, {{department}} | {{phone}}"
+
+	cases := []struct {
+		name string
+		user *directory.User
+		want string
+	}{
+		{
+			name: "all attributes",
+			user: &directory.User{
+				PrimaryEmail: "jane@example.com",
+				Name: &directory.UserName{
+					FullName:   "Jane Doe",
+					GivenName:  "Jane",
+					FamilyName: "Doe",
+				},
+				Organizations: []interface{}{
+					map[string]interface{}{"title": "Engineer", "department": "Platform", "primary": true},
+				},
+				Phones: []interface{}{
+					map[string]interface{}{"value": "+1 555 0100", "type": "work"},
+				},
+			},
+			want: "Jane Doe | Jane Doe | jane@example.com | Engineer, Platform | +1 555 0100",
+		},
+		{
+			name: "primary organization and phone win",
+			user: &directory.User{
+				PrimaryEmail: "jane@example.com",
+				Organizations: []interface{}{
+					map[string]interface{}{"title": "Volunteer", "department": "Events"},
+					map[string]interface{}{"title": "Engineer", "department": "Platform", "primary": true},
+				},
+				Phones: []interface{}{
+					map[string]interface{}{"value": "+1 555 0199", "type": "home"},
+					map[string]interface{}{"value": "+1 555 0100", "type": "work", "primary": true},
+				},
+			},
+			want: " |   | jane@example.com | Engineer, Platform | +1 555 0100",
+		},
+		{
+			name: "missing attributes",
+			user: &directory.User{
+				PrimaryEmail: "jane@example.com",
+			},
+			want: " |   | jane@example.com | ,  | ",
+		},
+		{
+			name: "escaped HTML",
+			user: &directory.User{
+				PrimaryEmail: "jane@example.com",
+				Name: &directory.UserName{
+					FullName:   `Jane "JD" <Doe>`,
+					GivenName:  "Jane",
+					FamilyName: "<Doe>",
+				},
+				Organizations: []interface{}{
+					map[string]interface{}{"title": "R&D", "department": "<script>alert(1)</script>"},
+				},
+			},
+			want: "Jane &#34;JD&#34; &lt;Doe&gt; | Jane &lt;Doe&gt; | jane@example.com | R&amp;D, &lt;script&gt;alert(1)&lt;/script&gt; | ",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := renderSignature(template, tc.user); got != tc.want {
+				t.Errorf("renderSignature() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEscapeQueryValue(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{"/Sales", "/Sales"},
+		{"/Sales/Partner's", `/Sales/Partner\'s`},
+		{`/Sales\EMEA`, `/Sales\\EMEA`},
+		{`/a\'b`, `/a\\\'b`},
+	}
+
+	for _, tc := range cases {
+		if got := escapeQueryValue(tc.value); got != tc.want {
+			t.Errorf("escapeQueryValue(%q) = %q, want %q", tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestSignaturesEqual(t *testing.T) {
+	cases := []struct {
+		current   string
+		signature string
+		want      bool
+	}{
+		{"<b>Jane</b>", "<b>Jane</b>", true},
+		{"<div>Jane<br></div>", "<div>Jane<br/></div>", true},
+		{"<div>Jane</div>", "<div>Jane", true},
+		{"<a href=\"https://example.com\">R&amp;D</a>", "<a href='https://example.com'>R&amp;D</a>", true},
+		{"Jane\n", "Jane", true},
+		{"<b>Jane</b>", "<i>Jane</i>", false},
+		{"Jane", "John", false},
+	}
+
+	for _, tc := range cases {
+		if got := signaturesEqual(tc.current, tc.signature); got != tc.want {
+			t.Errorf("signaturesEqual(%q, %q) = %t, want %t", tc.current, tc.signature, got, tc.want)
+		}
+	}
+}
+
+func TestIsMailServiceNotEnabledError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "no Gmail", err: &googleapi.Error{Code: 400, Message: "Mail service not enabled"}, want: true},
+		{name: "other precondition", err: &googleapi.Error{Code: 400, Message: "Precondition check failed."}, want: false},
+		{name: "forbidden", err: &googleapi.Error{Code: 403, Message: "Delegation denied"}, want: false},
+		{name: "not an API error", err: fmt.Errorf("connection reset"), want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isMailServiceNotEnabledError(tc.err); got != tc.want {
+				t.Errorf("isMailServiceNotEnabledError() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
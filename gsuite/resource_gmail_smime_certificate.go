@@ -0,0 +1,292 @@
+package gsuite
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailSmimeCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailSmimeCertificateCreate,
+		ReadContext:   resourceGmailSmimeCertificateRead,
+		UpdateContext: resourceGmailSmimeCertificateUpdate,
+		DeleteContext: resourceGmailSmimeCertificateDelete,
+
+		CustomizeDiff: resourceGmailSmimeCertificateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"send_as_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"smime_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// Only hashes of the key material end up in the state file, and
+			// the password not at all.
+			"pkcs12_file": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"pkcs12"},
+			},
+
+			// sha256 of the contents of pkcs12_file, changes trigger a new
+			// upload
+			"pkcs12_file_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// base64 encoded PKCS#12 bundle
+			"pkcs12": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				StateFunc:     hashSensitiveValue,
+				ConflictsWith: []string{"pkcs12_file"},
+			},
+
+			// write-only, so changing it doesn't upload the certificate
+			// again
+			"encrypted_key_password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+			},
+
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"issuer_cn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"pem": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// hashSensitiveValue is used as a StateFunc so secrets are never stored in
+// plain text.
+func hashSensitiveValue(v interface{}) string {
+	s := v.(string)
+	if s == "" {
+		return ""
+	}
+	return sha256Hex([]byte(s))
+}
+
+// readPkcs12 returns the configured PKCS#12 bundle.
+func readPkcs12(d *schema.ResourceData) ([]byte, error) {
+	if v, ok := d.GetOk("pkcs12_file"); ok {
+		return readPkcs12File(v.(string))
+	}
+
+	if v, ok := d.GetOk("pkcs12"); ok {
+		contents, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding pkcs12: %s", err)
+		}
+		return contents, nil
+	}
+
+	return nil, fmt.Errorf("one of pkcs12_file or pkcs12 must be set")
+}
+
+func readPkcs12File(path string) ([]byte, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading %s: %s", path, err)
+	}
+	return contents, nil
+}
+
+func sha256Hex(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// resourceGmailSmimeCertificateCustomizeDiff plans a new upload whenever the
+// contents of pkcs12_file no longer match what was last uploaded.
+func resourceGmailSmimeCertificateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// a path interpolated from other resources is only known at apply time
+	if !d.NewValueKnown("pkcs12_file") {
+		return d.SetNewComputed("pkcs12_file_sha256")
+	}
+
+	sum := ""
+	if v := d.Get("pkcs12_file").(string); v != "" {
+		contents, err := readPkcs12File(v)
+		if err != nil {
+			return err
+		}
+		sum = sha256Hex(contents)
+	}
+
+	old := d.Get("pkcs12_file_sha256").(string)
+	if sum == old {
+		return nil
+	}
+	if err := d.SetNew("pkcs12_file_sha256", sum); err != nil {
+		return err
+	}
+	// certificates uploaded before the hash was tracked only record it
+	if d.Id() != "" && old != "" {
+		log.Printf("[DEBUG] Contents of %s changed, planning an upload", d.Get("pkcs12_file").(string))
+		return d.ForceNew("pkcs12_file_sha256")
+	}
+	return nil
+}
+
+func resourceGmailSmimeCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	sendAsEmail := d.Get("send_as_email").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pkcs12, err := readPkcs12(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// write-only attributes are only available in the configuration
+	password, diags := d.GetRawConfigAt(cty.GetAttrPath("encrypted_key_password"))
+	if diags.HasError() {
+		return diags
+	}
+
+	smimeInfo := &gmail.SmimeInfo{
+		// the Gmail API expects base64url
+		Pkcs12: base64.URLEncoding.EncodeToString(pkcs12),
+	}
+	if password.IsKnown() && !password.IsNull() {
+		smimeInfo.EncryptedKeyPassword = password.AsString()
+	}
+
+	createdSmimeInfo, err := gmailSvc.Users.Settings.SendAs.SmimeInfo.Insert(userID, sendAsEmail, smimeInfo).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error uploading S/MIME certificate: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", userID, sendAsEmail, createdSmimeInfo.Id))
+	d.Set("smime_id", createdSmimeInfo.Id)
+	if _, ok := d.GetOk("pkcs12_file"); ok {
+		d.Set("pkcs12_file_sha256", sha256Hex(pkcs12))
+	}
+	log.Printf("[INFO] Uploaded S/MIME certificate %s for %s", createdSmimeInfo.Id, sendAsEmail)
+
+	if d.Get("is_default").(bool) {
+		if err := gmailSvc.Users.Settings.SendAs.SmimeInfo.SetDefault(userID, sendAsEmail, createdSmimeInfo.Id).Context(ctx).Do(); err != nil {
+			return diag.Errorf("Error setting default S/MIME certificate: %s", err)
+		}
+	}
+
+	return resourceGmailSmimeCertificateRead(ctx, d, meta)
+}
+
+func resourceGmailSmimeCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	sendAsEmail := d.Get("send_as_email").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A certificate stops being the default by making another one the
+	// default, so only setting it is supported.
+	if d.HasChange("is_default") && d.Get("is_default").(bool) {
+		log.Printf("[DEBUG] Setting S/MIME certificate %s as default", d.Id())
+		if err := gmailSvc.Users.Settings.SendAs.SmimeInfo.SetDefault(userID, sendAsEmail, d.Get("smime_id").(string)).Context(ctx).Do(); err != nil {
+			return diag.Errorf("Error setting default S/MIME certificate: %s", err)
+		}
+	}
+
+	return resourceGmailSmimeCertificateRead(ctx, d, meta)
+}
+
+func resourceGmailSmimeCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userID, sendAsEmail, smimeID := parts[0], parts[1], parts[2]
+
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	smimeInfo, err := gmailSvc.Users.Settings.SendAs.SmimeInfo.Get(userID, sendAsEmail, smimeID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("S/MIME certificate %s", d.Id())))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("send_as_email", sendAsEmail)
+	d.Set("smime_id", smimeInfo.Id)
+	d.Set("is_default", smimeInfo.IsDefault)
+	d.Set("issuer_cn", smimeInfo.IssuerCn)
+	d.Set("expiration", millisToRFC3339(smimeInfo.Expiration))
+	d.Set("pem", smimeInfo.Pem)
+
+	return nil
+}
+
+func resourceGmailSmimeCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Get("user_id").(string)
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = gmailSvc.Users.Settings.SendAs.SmimeInfo.Delete(userID, d.Get("send_as_email").(string), d.Get("smime_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting S/MIME certificate: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
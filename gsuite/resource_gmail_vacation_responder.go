@@ -0,0 +1,191 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gmail "google.golang.org/api/gmail/v1"
+)
+
+func resourceGmailVacationResponder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGmailVacationResponderCreate,
+		ReadContext:   resourceGmailVacationResponderRead,
+		UpdateContext: resourceGmailVacationResponderUpdate,
+		DeleteContext: resourceGmailVacationResponderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"body_html": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"body_plain_text": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"restrict_to_contacts": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"restrict_to_domain": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// RFC3339 timestamps, e.g. 2018-12-24T00:00:00Z
+			"start_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"end_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+		},
+	}
+}
+
+// rfc3339ToMillis converts an RFC3339 timestamp to milliseconds since the
+// epoch, as used by the Gmail API.
+func rfc3339ToMillis(v string) int64 {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func millisToRFC3339(v int64) string {
+	if v == 0 {
+		return ""
+	}
+	return time.Unix(0, v*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+func resourceGmailVacationResponderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("user_id").(string))
+	return resourceGmailVacationResponderUpdate(ctx, d, meta)
+}
+
+func resourceGmailVacationResponderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vacation := &gmail.VacationSettings{
+		EnableAutoReply:       d.Get("enabled").(bool),
+		ResponseSubject:       d.Get("subject").(string),
+		ResponseBodyHtml:      d.Get("body_html").(string),
+		ResponseBodyPlainText: d.Get("body_plain_text").(string),
+		RestrictToContacts:    d.Get("restrict_to_contacts").(bool),
+		RestrictToDomain:      d.Get("restrict_to_domain").(bool),
+		StartTime:             rfc3339ToMillis(d.Get("start_time").(string)),
+		EndTime:               rfc3339ToMillis(d.Get("end_time").(string)),
+		ForceSendFields: []string{
+			"EnableAutoReply",
+			"ResponseSubject",
+			"ResponseBodyHtml",
+			"ResponseBodyPlainText",
+			"RestrictToContacts",
+			"RestrictToDomain",
+		},
+	}
+
+	log.Printf("[DEBUG] Updating vacation responder for %s: %t", userID, vacation.EnableAutoReply)
+	_, err = gmailSvc.Users.Settings.UpdateVacation(userID, vacation).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error updating vacation responder: %s", err)
+	}
+
+	log.Printf("[INFO] Updated vacation responder for %s", userID)
+	return resourceGmailVacationResponderRead(ctx, d, meta)
+}
+
+func resourceGmailVacationResponderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vacation, err := gmailSvc.Users.Settings.GetVacation(userID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("vacation responder for %s", userID)))
+	}
+
+	d.Set("user_id", userID)
+	d.Set("enabled", vacation.EnableAutoReply)
+	d.Set("subject", vacation.ResponseSubject)
+	d.Set("body_html", vacation.ResponseBodyHtml)
+	d.Set("body_plain_text", vacation.ResponseBodyPlainText)
+	d.Set("restrict_to_contacts", vacation.RestrictToContacts)
+	d.Set("restrict_to_domain", vacation.RestrictToDomain)
+	d.Set("start_time", millisToRFC3339(vacation.StartTime))
+	d.Set("end_time", millisToRFC3339(vacation.EndTime))
+
+	return nil
+}
+
+func resourceGmailVacationResponderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	userID := d.Id()
+	gmailSvc, err := config.gmailService(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vacation := &gmail.VacationSettings{
+		EnableAutoReply: false,
+		ForceSendFields: []string{"EnableAutoReply"},
+	}
+
+	_, err = gmailSvc.Users.Settings.UpdateVacation(userID, vacation).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error disabling vacation responder: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
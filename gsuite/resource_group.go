@@ -1,24 +1,28 @@
 package gsuite
 
 import (
-	"fmt"
+	"context"
 	"log"
 
-	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	directory "google.golang.org/api/admin/directory/v1"
 )
 
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupCreate,
-		Read:   resourceGroupRead,
-		Update: resourceGroupUpdate,
-		Delete: resourceGroupDelete,
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		UpdateContext: resourceGroupUpdate,
+		DeleteContext: resourceGroupDelete,
+
+		CustomizeDiff: validateEmailDomain("email", false),
 
 		Schema: map[string]*schema.Schema{
 			"email": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentEmail,
 			},
 
 			"name": &schema.Schema{
@@ -56,7 +60,7 @@ func resourceGroup() *schema.Resource {
 	}
 }
 
-func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	group := &directory.Group{
@@ -73,17 +77,17 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		group.Description = v.(string)
 	}
 
-	createdGroup, err := config.directory.Groups.Insert(group).Do()
+	createdGroup, err := config.directory.Groups.Insert(group).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error creating group: %s", err)
+		return diag.Errorf("Error creating group: %s", err)
 	}
 
   d.SetId(createdGroup.Id)
 	log.Printf("[INFO] Created group: %s", createdGroup.Email)
-	return resourceGroupRead(d, meta)
+	return resourceGroupRead(ctx, d, meta)
 }
 
-func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	group := &directory.Group{}
@@ -120,21 +124,21 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		group.NullFields = nullFields
 	}
 
-	updatedGroup, err := config.directory.Groups.Patch(d.Id(), group).Do()
+	updatedGroup, err := config.directory.Groups.Patch(d.Id(), group).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error updating group: %s", err)
+		return diag.Errorf("Error updating group: %s", err)
 	}
 
 	log.Printf("[INFO] Updated group: %s", updatedGroup.Email)
-	return resourceGroupRead(d, meta)
+	return resourceGroupRead(ctx, d, meta)
 }
 
-func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	group, err := config.directory.Groups.Get(d.Id()).Do()
+	group, err := config.directory.Groups.Get(d.Id()).Context(ctx).Do()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
   d.SetId(group.Id)
@@ -146,12 +150,12 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	err := config.directory.Groups.Delete(d.Id()).Do()
+	err := config.directory.Groups.Delete(d.Id()).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error deleting group: %s", err)
+		return diag.Errorf("Error deleting group: %s", err)
 	}
 
 	d.SetId("")
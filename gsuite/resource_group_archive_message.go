@@ -0,0 +1,117 @@
+package gsuite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+)
+
+// resourceGroupArchiveMessage inserts an RFC822 message into the archive of
+// a group. Archived messages cannot be read back or removed through the API,
+// so destroying the resource only removes it from state.
+func resourceGroupArchiveMessage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupArchiveMessageCreate,
+		ReadContext:   resourceGroupArchiveMessageRead,
+		DeleteContext: resourceGroupArchiveMessageDelete,
+
+		CustomizeDiff: resourceGroupArchiveMessageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"group_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// path to a local RFC822 (.eml) message
+			"source": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// md5 of the message, changes insert the message again
+			"content_md5": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupArchiveMessageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// a source interpolated from other resources is only known at apply time
+	if !d.NewValueKnown("source") {
+		if err := d.SetNewComputed("content_md5"); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("content_md5")
+		}
+		return nil
+	}
+
+	source := d.Get("source").(string)
+	content, err := ioutil.ReadFile(source)
+	if err != nil {
+		return fmt.Errorf("Error reading %s: %s", source, err)
+	}
+
+	if sum := md5Hex(content); sum != d.Get("content_md5").(string) {
+		log.Printf("[DEBUG] Content of %s changed, planning an insert", source)
+		if err := d.SetNew("content_md5", sum); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("content_md5")
+		}
+	}
+	return nil
+}
+
+func resourceGroupArchiveMessageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	config.checkAPIEnabled("groups_migration")
+
+	source := d.Get("source").(string)
+	content, err := ioutil.ReadFile(source)
+	if err != nil {
+		return diag.Errorf("Error reading %s: %s", source, err)
+	}
+
+	groupEmail := d.Get("group_email").(string)
+	resp, err := config.groupsMigration.Archive.Insert(groupEmail).
+		Media(bytes.NewReader(content), googleapi.ContentType("message/rfc822")).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error inserting message into the archive of %s: %s", groupEmail, err)
+	}
+	if resp.ResponseCode != "SUCCESS" {
+		return diag.Errorf("Error inserting message into the archive of %s: %s", groupEmail, resp.ResponseCode)
+	}
+
+	sum := md5Hex(content)
+	d.SetId(fmt.Sprintf("%s/%s", groupEmail, sum))
+	d.Set("content_md5", sum)
+	log.Printf("[INFO] Inserted %s into the archive of %s", source, groupEmail)
+	return resourceGroupArchiveMessageRead(ctx, d, meta)
+}
+
+// resourceGroupArchiveMessageRead has nothing to refresh, the API cannot
+// read archived messages.
+func resourceGroupArchiveMessageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceGroupArchiveMessageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Archived messages cannot be deleted, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -1,24 +1,28 @@
 package gsuite
 
 import (
-	"fmt"
+	"context"
 	"log"
 
-	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	directory "google.golang.org/api/admin/directory/v1"
 )
 
 func resourceGroupMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupMemberCreate,
-		Read:   resourceGroupMemberRead,
-		Update: resourceGroupMemberUpdate,
-		Delete: resourceGroupMemberDelete,
+		CreateContext: resourceGroupMemberCreate,
+		ReadContext:   resourceGroupMemberRead,
+		UpdateContext: resourceGroupMemberUpdate,
+		DeleteContext: resourceGroupMemberDelete,
+
+		CustomizeDiff: validateEmailDomain("email", true),
 
 		Schema: map[string]*schema.Schema{
 			"group": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentDirectoryKey,
 			},
 
 			"etag": &schema.Schema{
@@ -48,14 +52,15 @@ func resourceGroupMember() *schema.Resource {
 			},
 
 			"email": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentEmail,
 			},
 		},
 	}
 }
 
-func resourceGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	group := d.Get("group").(string)
@@ -65,17 +70,17 @@ func resourceGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
 		Email: d.Get("email").(string),
 	}
 
-	createdGroupMember, err := config.directory.Members.Insert(group, groupMember).Do()
+	createdGroupMember, err := config.directory.Members.Insert(group, groupMember).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error creating groupMember: %s", err)
+		return diag.Errorf("Error creating groupMember: %s", err)
 	}
 
   d.SetId(createdGroupMember.Id)
 	log.Printf("[INFO] Created group: %s", createdGroupMember.Email)
-	return resourceGroupMemberRead(d, meta)
+	return resourceGroupMemberRead(ctx, d, meta)
 }
 
-func resourceGroupMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	groupMember := &directory.Member{}
@@ -90,21 +95,21 @@ func resourceGroupMemberUpdate(d *schema.ResourceData, meta interface{}) error {
 		groupMember.NullFields = nullFields
 	}
 
-	updatedGroupMember, err := config.directory.Members.Patch(d.Get("group").(string), d.Id(), groupMember).Do()
+	updatedGroupMember, err := config.directory.Members.Patch(d.Get("group").(string), d.Id(), groupMember).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error updating groupMember: %s", err)
+		return diag.Errorf("Error updating groupMember: %s", err)
 	}
 
 	log.Printf("[INFO] Updated groupMember: %s", updatedGroupMember.Email)
-	return resourceGroupMemberRead(d, meta)
+	return resourceGroupMemberRead(ctx, d, meta)
 }
 
-func resourceGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	groupMember, err := config.directory.Members.Get(d.Get("group").(string), d.Id()).Do()
+	groupMember, err := config.directory.Members.Get(d.Get("group").(string), d.Id()).Context(ctx).Do()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
   d.SetId(groupMember.Id)
@@ -117,12 +122,12 @@ func resourceGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	err := config.directory.Members.Delete(d.Get("group").(string), d.Id()).Do()
+	err := config.directory.Members.Delete(d.Get("group").(string), d.Id()).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error deleting group: %s", err)
+		return diag.Errorf("Error deleting group: %s", err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,143 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	meet "google.golang.org/api/meet/v2"
+)
+
+// resourceMeetSpace manages a standing meeting space owned by the provider's
+// impersonated user. Spaces cannot be deleted through the API, so destroying
+// the resource only removes it from state.
+func resourceMeetSpace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMeetSpaceCreate,
+		ReadContext:   resourceMeetSpaceRead,
+		UpdateContext: resourceMeetSpaceUpdate,
+		DeleteContext: resourceMeetSpaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// OPEN, TRUSTED or RESTRICTED
+			"access_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// ALL or CREATOR_APP_ONLY
+			"entry_point_access": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"meeting_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"meeting_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMeetSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	meetSvc, err := config.meetService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	space := &meet.Space{
+		Config: &meet.SpaceConfig{
+			AccessType:       d.Get("access_type").(string),
+			EntryPointAccess: d.Get("entry_point_access").(string),
+		},
+	}
+
+	createdSpace, err := meetSvc.Spaces.Create(space).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating meet space: %s", err)
+	}
+
+	d.SetId(createdSpace.Name)
+	log.Printf("[INFO] Created meet space: %s", createdSpace.MeetingUri)
+	return resourceMeetSpaceRead(ctx, d, meta)
+}
+
+func resourceMeetSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	meetSvc, err := config.meetService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spaceConfig := &meet.SpaceConfig{}
+	updateMask := []string{}
+
+	if d.HasChange("access_type") {
+		log.Printf("[DEBUG] Updating meet space access_type: %s", d.Get("access_type").(string))
+		spaceConfig.AccessType = d.Get("access_type").(string)
+		updateMask = append(updateMask, "config.accessType")
+	}
+	if d.HasChange("entry_point_access") {
+		log.Printf("[DEBUG] Updating meet space entry_point_access: %s", d.Get("entry_point_access").(string))
+		spaceConfig.EntryPointAccess = d.Get("entry_point_access").(string)
+		updateMask = append(updateMask, "config.entryPointAccess")
+	}
+
+	if len(updateMask) > 0 {
+		_, err := meetSvc.Spaces.Patch(d.Id(), &meet.Space{Config: spaceConfig}).UpdateMask(strings.Join(updateMask, ",")).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating meet space: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated meet space: %s", d.Id())
+	return resourceMeetSpaceRead(ctx, d, meta)
+}
+
+func resourceMeetSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	meetSvc, err := config.meetService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	space, err := meetSvc.Spaces.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("meet space %s", d.Id())))
+	}
+
+	d.Set("meeting_uri", space.MeetingUri)
+	d.Set("meeting_code", space.MeetingCode)
+	if space.Config != nil {
+		d.Set("access_type", space.Config.AccessType)
+		d.Set("entry_point_access", space.Config.EntryPointAccess)
+	}
+
+	return nil
+}
+
+func resourceMeetSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The meeting link stays valid, so ending a live meeting here would
+	// only kick everyone out of it.
+	log.Printf("[WARN] Meet spaces cannot be deleted, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,250 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	reseller "google.golang.org/api/reseller/v1"
+)
+
+// resourceResellerCustomer manages a customer of the reseller. Customers
+// cannot be deleted through the API, so destroying the resource only removes
+// it from state. Cancel the customer's subscriptions to end the relation.
+func resourceResellerCustomer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResellerCustomerCreate,
+		ReadContext:   resourceResellerCustomerRead,
+		UpdateContext: resourceResellerCustomerUpdate,
+		DeleteContext: resourceResellerCustomerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"customer_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// an address outside the customer's domain
+			"alternate_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only needed to transfer an existing customer to the reseller
+			"customer_auth_token": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				ForceNew:  true,
+			},
+
+			"postal_address": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"organization_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"address_line1": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"address_line2": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"address_line3": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"locality": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"postal_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// ISO 3166 two letter code
+						"country_code": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"customer_domain_verified": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"resource_ui_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandResellerAddress(d *schema.ResourceData) *reseller.Address {
+	prefix := "postal_address.0"
+	return &reseller.Address{
+		ContactName:      d.Get(prefix + ".contact_name").(string),
+		OrganizationName: d.Get(prefix + ".organization_name").(string),
+		AddressLine1:     d.Get(prefix + ".address_line1").(string),
+		AddressLine2:     d.Get(prefix + ".address_line2").(string),
+		AddressLine3:     d.Get(prefix + ".address_line3").(string),
+		Locality:         d.Get(prefix + ".locality").(string),
+		Region:           d.Get(prefix + ".region").(string),
+		PostalCode:       d.Get(prefix + ".postal_code").(string),
+		CountryCode:      d.Get(prefix + ".country_code").(string),
+	}
+}
+
+func flattenResellerAddress(address *reseller.Address) []map[string]interface{} {
+	if address == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"contact_name":      address.ContactName,
+			"organization_name": address.OrganizationName,
+			"address_line1":     address.AddressLine1,
+			"address_line2":     address.AddressLine2,
+			"address_line3":     address.AddressLine3,
+			"locality":          address.Locality,
+			"region":            address.Region,
+			"postal_code":       address.PostalCode,
+			"country_code":      address.CountryCode,
+		},
+	}
+}
+
+func resourceResellerCustomerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	customer := &reseller.Customer{
+		CustomerDomain: d.Get("customer_domain").(string),
+		AlternateEmail: d.Get("alternate_email").(string),
+		PhoneNumber:    d.Get("phone_number").(string),
+		PostalAddress:  expandResellerAddress(d),
+	}
+
+	call := resellerSvc.Customers.Insert(customer)
+	if v, ok := d.GetOk("customer_auth_token"); ok {
+		log.Printf("[DEBUG] Transferring existing customer %s", customer.CustomerDomain)
+		call = call.CustomerAuthToken(v.(string))
+	}
+
+	createdCustomer, err := call.Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating reseller customer: %s", err)
+	}
+
+	d.SetId(createdCustomer.CustomerId)
+	log.Printf("[INFO] Created reseller customer: %s", createdCustomer.CustomerDomain)
+	return resourceResellerCustomerRead(ctx, d, meta)
+}
+
+func resourceResellerCustomerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	customer := &reseller.Customer{}
+	changed := false
+
+	if d.HasChange("alternate_email") {
+		log.Printf("[DEBUG] Updating reseller customer alternate_email: %s", d.Get("alternate_email").(string))
+		customer.AlternateEmail = d.Get("alternate_email").(string)
+		changed = true
+	}
+	if d.HasChange("phone_number") {
+		log.Printf("[DEBUG] Updating reseller customer phone_number: %s", d.Get("phone_number").(string))
+		customer.PhoneNumber = d.Get("phone_number").(string)
+		customer.ForceSendFields = append(customer.ForceSendFields, "PhoneNumber")
+		changed = true
+	}
+	if d.HasChange("postal_address") {
+		log.Printf("[DEBUG] Updating reseller customer postal_address")
+		customer.PostalAddress = expandResellerAddress(d)
+		changed = true
+	}
+
+	if changed {
+		_, err := resellerSvc.Customers.Patch(d.Id(), customer).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating reseller customer: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated reseller customer: %s", d.Id())
+	return resourceResellerCustomerRead(ctx, d, meta)
+}
+
+func resourceResellerCustomerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	customer, err := resellerSvc.Customers.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("reseller customer %s", d.Id())))
+	}
+
+	d.Set("customer_id", customer.CustomerId)
+	d.Set("customer_domain", customer.CustomerDomain)
+	d.Set("alternate_email", customer.AlternateEmail)
+	d.Set("phone_number", customer.PhoneNumber)
+	d.Set("postal_address", flattenResellerAddress(customer.PostalAddress))
+	d.Set("customer_domain_verified", customer.CustomerDomainVerified)
+	d.Set("resource_ui_url", customer.ResourceUiUrl)
+
+	return nil
+}
+
+func resourceResellerCustomerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Reseller customers cannot be deleted, removing %s from state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,250 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	reseller "google.golang.org/api/reseller/v1"
+)
+
+func resourceResellerSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResellerSubscriptionCreate,
+		ReadContext:   resourceResellerSubscriptionRead,
+		UpdateContext: resourceResellerSubscriptionUpdate,
+		DeleteContext: resourceResellerSubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the customer's domain or unique ID
+			"customer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// e.g. 1010020027 for G Suite Business
+			"sku_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// ANNUAL_MONTHLY_PAY, ANNUAL_YEARLY_PAY, FLEXIBLE or TRIAL
+			"plan_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// the seats paid for, used by annual plans
+			"number_of_seats": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// the maximum number of licenses, used by flexible and trial plans
+			"maximum_number_of_seats": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// AUTO_RENEW_MONTHLY_PAY, AUTO_RENEW_YEARLY_PAY, CANCEL,
+			// RENEW_CURRENT_USERS_MONTHLY_PAY, RENEW_CURRENT_USERS_YEARLY_PAY,
+			// SWITCH_TO_PAY_AS_YOU_GO, only used by annual plans
+			"renewal_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"purchase_order_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// cancel or transfer_to_direct
+			"deletion_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cancel",
+			},
+
+			"subscription_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sku_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"licensed_number_of_seats": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"resource_ui_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandResellerSeats(d *schema.ResourceData) *reseller.Seats {
+	return &reseller.Seats{
+		NumberOfSeats:        int64(d.Get("number_of_seats").(int)),
+		MaximumNumberOfSeats: int64(d.Get("maximum_number_of_seats").(int)),
+	}
+}
+
+func resourceResellerSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	customerID := d.Get("customer_id").(string)
+	subscription := &reseller.Subscription{
+		CustomerId: customerID,
+		SkuId:      d.Get("sku_id").(string),
+		Plan: &reseller.SubscriptionPlan{
+			PlanName: d.Get("plan_name").(string),
+		},
+		Seats:           expandResellerSeats(d),
+		PurchaseOrderId: d.Get("purchase_order_id").(string),
+	}
+
+	if v, ok := d.GetOk("renewal_type"); ok {
+		log.Printf("[DEBUG] Setting subscription renewal_type: %s", v.(string))
+		subscription.RenewalSettings = &reseller.RenewalSettings{
+			RenewalType: v.(string),
+		}
+	}
+
+	createdSubscription, err := resellerSvc.Subscriptions.Insert(customerID, subscription).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating reseller subscription: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", customerID, createdSubscription.SubscriptionId))
+	log.Printf("[INFO] Created reseller subscription %s for %s", createdSubscription.SkuId, customerID)
+	return resourceResellerSubscriptionRead(ctx, d, meta)
+}
+
+func resourceResellerSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	customerID := d.Get("customer_id").(string)
+	subscriptionID := d.Get("subscription_id").(string)
+
+	// A plan change carries the seats of the new plan, so seats are only
+	// changed separately when the plan stays the same
+	if d.HasChange("plan_name") {
+		log.Printf("[DEBUG] Changing subscription plan: %s", d.Get("plan_name").(string))
+		_, err := resellerSvc.Subscriptions.ChangePlan(customerID, subscriptionID, &reseller.ChangePlanRequest{
+			PlanName:        d.Get("plan_name").(string),
+			Seats:           expandResellerSeats(d),
+			PurchaseOrderId: d.Get("purchase_order_id").(string),
+		}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error changing reseller subscription plan: %s", err)
+		}
+	} else if d.HasChange("number_of_seats") || d.HasChange("maximum_number_of_seats") {
+		log.Printf("[DEBUG] Changing subscription seats")
+		_, err := resellerSvc.Subscriptions.ChangeSeats(customerID, subscriptionID, expandResellerSeats(d)).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error changing reseller subscription seats: %s", err)
+		}
+	}
+
+	if d.HasChange("renewal_type") {
+		log.Printf("[DEBUG] Changing subscription renewal_type: %s", d.Get("renewal_type").(string))
+		_, err := resellerSvc.Subscriptions.ChangeRenewalSettings(customerID, subscriptionID, &reseller.RenewalSettings{
+			RenewalType: d.Get("renewal_type").(string),
+		}).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error changing reseller subscription renewal settings: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated reseller subscription: %s", d.Id())
+	return resourceResellerSubscriptionRead(ctx, d, meta)
+}
+
+func resourceResellerSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	customerID, subscriptionID := parts[0], parts[1]
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subscription, err := resellerSvc.Subscriptions.Get(customerID, subscriptionID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("reseller subscription %s", d.Id())))
+	}
+
+	d.Set("customer_id", customerID)
+	d.Set("subscription_id", subscription.SubscriptionId)
+	d.Set("sku_id", subscription.SkuId)
+	d.Set("sku_name", subscription.SkuName)
+	d.Set("status", subscription.Status)
+	d.Set("purchase_order_id", subscription.PurchaseOrderId)
+	d.Set("resource_ui_url", subscription.ResourceUiUrl)
+	if subscription.Plan != nil {
+		d.Set("plan_name", subscription.Plan.PlanName)
+	}
+	if subscription.Seats != nil {
+		d.Set("number_of_seats", int(subscription.Seats.NumberOfSeats))
+		d.Set("maximum_number_of_seats", int(subscription.Seats.MaximumNumberOfSeats))
+		d.Set("licensed_number_of_seats", int(subscription.Seats.LicensedNumberOfSeats))
+	}
+	if subscription.RenewalSettings != nil {
+		d.Set("renewal_type", subscription.RenewalSettings.RenewalType)
+	}
+
+	return nil
+}
+
+func resourceResellerSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	resellerSvc, err := config.resellerService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resellerSvc.Subscriptions.Delete(d.Get("customer_id").(string), d.Get("subscription_id").(string), d.Get("deletion_type").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting reseller subscription: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
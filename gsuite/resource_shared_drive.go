@@ -0,0 +1,335 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drive "google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+func resourceSharedDrive() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedDriveCreate,
+		ReadContext:   resourceSharedDriveRead,
+		UpdateContext: resourceSharedDriveUpdate,
+		DeleteContext: resourceSharedDriveDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the user to act as instead of the provider's
+			// impersonated_user_email
+			"impersonated_user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// write-only, the API does not return the theme of a drive
+			"theme_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hidden": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// act as a domain administrator, required to manage shared drives
+			// the impersonated user is not a member of
+			"use_domain_admin_access": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"restrictions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// whether the other restrictions can only be changed
+						// by administrators
+						"admin_managed_restrictions": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"copy_requires_writer_permission": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"domain_users_only": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"drive_members_only": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			// the idempotency key used when creating the drive
+			"request_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"color_rgb": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandSharedDriveRestrictions(d *schema.ResourceData) *drive.DriveRestrictions {
+	prefix := "restrictions.0"
+	return &drive.DriveRestrictions{
+		AdminManagedRestrictions:     d.Get(prefix + ".admin_managed_restrictions").(bool),
+		CopyRequiresWriterPermission: d.Get(prefix + ".copy_requires_writer_permission").(bool),
+		DomainUsersOnly:              d.Get(prefix + ".domain_users_only").(bool),
+		DriveMembersOnly:             d.Get(prefix + ".drive_members_only").(bool),
+		ForceSendFields: []string{
+			"AdminManagedRestrictions",
+			"CopyRequiresWriterPermission",
+			"DomainUsersOnly",
+			"DriveMembersOnly",
+		},
+	}
+}
+
+func flattenSharedDriveRestrictions(restrictions *drive.DriveRestrictions) []map[string]interface{} {
+	if restrictions == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"admin_managed_restrictions":      restrictions.AdminManagedRestrictions,
+			"copy_requires_writer_permission": restrictions.CopyRequiresWriterPermission,
+			"domain_users_only":               restrictions.DomainUsersOnly,
+			"drive_members_only":              restrictions.DriveMembersOnly,
+		},
+	}
+}
+
+func isRetryableDriveError(err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	return ok && (gerr.Code == 429 || gerr.Code >= 500)
+}
+
+// findCreatedSharedDrive returns the shared drive an earlier attempt of a
+// create created, whose response was lost. Drive doesn't expose the request ID
+// of drives, so it is the one drive with the name created since the first
+// attempt.
+func findCreatedSharedDrive(ctx context.Context, driveSvc *drive.Service, name string, since time.Time, useDomainAdminAccess bool) (*drive.Drive, error) {
+	query := fmt.Sprintf("name = '%s' and createdTime >= '%s'", escapeDriveQuery(name), since.UTC().Format(time.RFC3339))
+	resp, err := driveSvc.Drives.List().Q(query).UseDomainAdminAccess(useDomainAdminAccess).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Drives) != 1 {
+		return nil, fmt.Errorf("expected 1 shared drive named %q created since %s, found %d", name, since.Format(time.RFC3339), len(resp.Drives))
+	}
+	return resp.Drives[0], nil
+}
+
+func resourceSharedDriveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sharedDrive := &drive.Drive{
+		Name: d.Get("name").(string),
+	}
+
+	if v, ok := d.GetOk("theme_id"); ok {
+		log.Printf("[DEBUG] Setting shared drive theme_id: %s", v.(string))
+		sharedDrive.ThemeId = v.(string)
+	}
+
+	// The same request ID is reused for every attempt, so a retried request
+	// never creates a second drive.
+	requestID := id.UniqueId()
+	d.Set("request_id", requestID)
+
+	useDomainAdminAccess := d.Get("use_domain_admin_access").(bool)
+	// allow for clock skew with the Drive servers
+	since := time.Now().Add(-time.Minute)
+	var createdDrive *drive.Drive
+	err = retry.RetryContext(ctx, config.operationTimeout(d, schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		createdDrive, err = driveSvc.Drives.Create(requestID, sharedDrive).Context(ctx).Do()
+		if err == nil {
+			return nil
+		}
+
+		// The request ID is fresh, so a conflict is always a drive created
+		// by an earlier attempt, including ones retried by the transport.
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 409 {
+			log.Printf("[DEBUG] Shared drive for request %s already exists, looking it up", requestID)
+			createdDrive, err = findCreatedSharedDrive(ctx, driveSvc, sharedDrive.Name, since, useDomainAdminAccess)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("shared drive for request %s already exists: %s", requestID, err))
+			}
+			return nil
+		}
+
+		if isRetryableDriveError(err) {
+			log.Printf("[DEBUG] Retrying shared drive creation: %s", err)
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+	if err != nil {
+		return diag.Errorf("Error creating shared drive: %s", err)
+	}
+
+	d.SetId(createdDrive.Id)
+	log.Printf("[INFO] Created shared drive: %s", createdDrive.Name)
+
+	if d.Get("hidden").(bool) {
+		if _, err := driveSvc.Drives.Hide(createdDrive.Id).Context(ctx).Do(); err != nil {
+			return diag.Errorf("Error hiding shared drive: %s", err)
+		}
+	}
+
+	// Restrictions can only be applied once the drive exists
+	if _, ok := d.GetOk("restrictions"); ok {
+		log.Printf("[DEBUG] Setting shared drive restrictions")
+		sharedDrive := &drive.Drive{
+			Restrictions: expandSharedDriveRestrictions(d),
+		}
+		_, err := driveSvc.Drives.Update(createdDrive.Id, sharedDrive).UseDomainAdminAccess(useDomainAdminAccess).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error setting shared drive restrictions: %s", err)
+		}
+	}
+
+	return resourceSharedDriveRead(ctx, d, meta)
+}
+
+func resourceSharedDriveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sharedDrive := &drive.Drive{}
+	changed := false
+
+	if d.HasChange("name") {
+		log.Printf("[DEBUG] Updating shared drive name: %s", d.Get("name").(string))
+		sharedDrive.Name = d.Get("name").(string)
+		changed = true
+	}
+	if d.HasChange("theme_id") {
+		log.Printf("[DEBUG] Updating shared drive theme_id: %s", d.Get("theme_id").(string))
+		sharedDrive.ThemeId = d.Get("theme_id").(string)
+		changed = true
+	}
+	if d.HasChange("restrictions") {
+		log.Printf("[DEBUG] Updating shared drive restrictions")
+		sharedDrive.Restrictions = expandSharedDriveRestrictions(d)
+		changed = true
+	}
+
+	if changed {
+		_, err := driveSvc.Drives.Update(d.Id(), sharedDrive).UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating shared drive: %s", err)
+		}
+	}
+
+	if d.HasChange("hidden") {
+		if d.Get("hidden").(bool) {
+			log.Printf("[DEBUG] Hiding shared drive %s", d.Id())
+			_, err = driveSvc.Drives.Hide(d.Id()).Context(ctx).Do()
+		} else {
+			log.Printf("[DEBUG] Unhiding shared drive %s", d.Id())
+			_, err = driveSvc.Drives.Unhide(d.Id()).Context(ctx).Do()
+		}
+		if err != nil {
+			return diag.Errorf("Error updating shared drive visibility: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated shared drive: %s", d.Id())
+	return resourceSharedDriveRead(ctx, d, meta)
+}
+
+func resourceSharedDriveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sharedDrive, err := driveSvc.Drives.Get(d.Id()).UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("shared drive %s", d.Id())))
+	}
+
+	d.Set("name", sharedDrive.Name)
+	d.Set("hidden", sharedDrive.Hidden)
+	d.Set("restrictions", flattenSharedDriveRestrictions(sharedDrive.Restrictions))
+	d.Set("color_rgb", sharedDrive.ColorRgb)
+	d.Set("created_time", sharedDrive.CreatedTime)
+
+	return nil
+}
+
+func resourceSharedDriveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = driveSvc.Drives.Delete(d.Id()).UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting shared drive: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
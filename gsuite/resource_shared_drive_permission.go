@@ -0,0 +1,201 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	drive "google.golang.org/api/drive/v3"
+)
+
+func resourceSharedDrivePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedDrivePermissionCreate,
+		ReadContext:   resourceSharedDrivePermissionRead,
+		UpdateContext: resourceSharedDrivePermissionUpdate,
+		DeleteContext: resourceSharedDrivePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// the user to act as instead of the provider's
+			// impersonated_user_email
+			"impersonated_user_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"drive_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// user, group or domain
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// user or group email address
+			"email_address": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"domain"},
+			},
+
+			"domain": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"email_address"},
+			},
+
+			// organizer (manager), fileOrganizer (content manager),
+			// writer (contributor), commenter or reader
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"send_notification_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"use_domain_admin_access": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"permission_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedDrivePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	driveID := d.Get("drive_id").(string)
+	permission := &drive.Permission{
+		Type:         d.Get("type").(string),
+		Role:         d.Get("role").(string),
+		EmailAddress: d.Get("email_address").(string),
+		Domain:       d.Get("domain").(string),
+	}
+
+	createdPermission, err := driveSvc.Permissions.Create(driveID, permission).
+		SupportsAllDrives(true).
+		UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+		SendNotificationEmail(d.Get("send_notification_email").(bool)).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating shared drive permission: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", driveID, createdPermission.Id))
+	log.Printf("[INFO] Created shared drive permission %s on %s", createdPermission.Id, driveID)
+	return resourceSharedDrivePermissionRead(ctx, d, meta)
+}
+
+func resourceSharedDrivePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("role") {
+		permission := &drive.Permission{
+			Role: d.Get("role").(string),
+		}
+
+		log.Printf("[DEBUG] Updating shared drive permission role: %s", permission.Role)
+		_, err := driveSvc.Permissions.Update(d.Get("drive_id").(string), d.Get("permission_id").(string), permission).
+			SupportsAllDrives(true).
+			UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+			Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating shared drive permission: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated shared drive permission: %s", d.Id())
+	return resourceSharedDrivePermissionRead(ctx, d, meta)
+}
+
+func resourceSharedDrivePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	driveID, permissionID := parts[0], parts[1]
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := driveSvc.Permissions.Get(driveID, permissionID).
+		SupportsAllDrives(true).
+		UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("shared drive permission %s", d.Id())))
+	}
+
+	d.Set("drive_id", driveID)
+	d.Set("permission_id", permission.Id)
+	d.Set("type", permission.Type)
+	d.Set("role", permission.Role)
+	d.Set("email_address", permission.EmailAddress)
+	d.Set("domain", permission.Domain)
+	d.Set("display_name", permission.DisplayName)
+
+	return nil
+}
+
+func resourceSharedDrivePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	driveSvc, err := config.driveService(d.Get("impersonated_user_email").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = driveSvc.Permissions.Delete(d.Get("drive_id").(string), d.Get("permission_id").(string)).
+		SupportsAllDrives(true).
+		UseDomainAdminAccess(d.Get("use_domain_admin_access").(bool)).
+		Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting shared drive permission: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,134 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+	siteverification "google.golang.org/api/siteverification/v1"
+)
+
+// resourceSiteVerification verifies ownership of a domain once the token of
+// gsuite_site_verification_token is published. Verification is retried until
+// the create timeout while the DNS record propagates.
+func resourceSiteVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSiteVerificationCreate,
+		ReadContext:   resourceSiteVerificationRead,
+		DeleteContext: resourceSiteVerificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// DNS_TXT or DNS_CNAME
+			"verification_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "DNS_TXT",
+				ForceNew: true,
+			},
+
+			"owners": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSiteVerificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	siteVerificationSvc, err := config.siteVerificationService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain := d.Get("domain").(string)
+	webResource := &siteverification.SiteVerificationWebResourceResource{
+		Site: &siteverification.SiteVerificationWebResourceResourceSite{
+			Identifier: domain,
+			Type:       "INET_DOMAIN",
+		},
+	}
+
+	var verified *siteverification.SiteVerificationWebResourceResource
+	err = retry.RetryContext(ctx, config.operationTimeout(d, schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		verified, err = siteVerificationSvc.WebResource.Insert(d.Get("verification_method").(string), webResource).Context(ctx).Do()
+		if err == nil {
+			return nil
+		}
+
+		// The token is not found until the DNS record has propagated
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 400 {
+			log.Printf("[DEBUG] Retrying verification of %s: %s", domain, err)
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+	if err != nil {
+		return diag.Errorf("Error verifying site %s: %s", domain, err)
+	}
+
+	d.SetId(verified.Id)
+	log.Printf("[INFO] Verified site: %s", domain)
+	return resourceSiteVerificationRead(ctx, d, meta)
+}
+
+func resourceSiteVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	siteVerificationSvc, err := config.siteVerificationService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	webResource, err := siteVerificationSvc.WebResource.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("site verification %s", d.Id())))
+	}
+
+	if webResource.Site != nil {
+		d.Set("domain", webResource.Site.Identifier)
+	}
+	d.Set("owners", webResource.Owners)
+
+	return nil
+}
+
+func resourceSiteVerificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	siteVerificationSvc, err := config.siteVerificationService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removes the impersonated user's ownership, other owners keep theirs
+	err = siteVerificationSvc.WebResource.Delete(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting site verification: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -1,10 +1,12 @@
 package gsuite
 
 import (
+	"context"
 	"fmt"
 	"log"
 
-	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	directory "google.golang.org/api/admin/directory/v1"
 )
 
@@ -71,10 +73,12 @@ func flattenUserSshPublicKeys(sshPublicKeys []*directory.UserSshPublicKey) []map
 
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUserCreate,
-		Read:   resourceUserRead,
-		Update: resourceUserUpdate,
-		Delete: resourceUserDelete,
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+
+		CustomizeDiff: validateEmailDomain("primary_email", false),
 
 		Schema: map[string]*schema.Schema{
 			"aliases": &schema.Schema{
@@ -235,8 +239,9 @@ func resourceUser() *schema.Resource {
 			},
 
 			"primary_email": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentEmail,
 			},
 
 			"ssh_public_keys": &schema.Schema{
@@ -274,7 +279,7 @@ func resourceUser() *schema.Resource {
 	}
 }
 
-func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	user := &directory.User{}
@@ -302,19 +307,19 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 
 
 	if v, ok := d.GetOk("change_password_next_login"); ok {
-		log.Printf("[DEBUG] Setting %s: %s", "change_password_next_login", v.(bool))
+		log.Printf("[DEBUG] Setting %s: %t", "change_password_next_login", v.(bool))
 		user.ChangePasswordAtNextLogin = v.(bool)
 	}
 	if v, ok := d.GetOk("include_in_global_list"); ok {
-		log.Printf("[DEBUG] Setting %s: %s", "include_in_global_list", v.(bool))
+		log.Printf("[DEBUG] Setting %s: %t", "include_in_global_list", v.(bool))
 		user.IncludeInGlobalAddressList = v.(bool)
 	}
 	if v, ok := d.GetOk("is_ip_whitelisted"); ok {
-		log.Printf("[DEBUG] Setting %s: %s", "is_ip_whitelisted", v.(bool))
+		log.Printf("[DEBUG] Setting %s: %t", "is_ip_whitelisted", v.(bool))
 		user.IpWhitelisted = v.(bool)
 	}
 	if v, ok := d.GetOk("is_suspended"); ok {
-		log.Printf("[DEBUG] Setting %s: %s", "is_suspended", v.(bool))
+		log.Printf("[DEBUG] Setting %s: %t", "is_suspended", v.(bool))
 		user.Suspended = v.(bool)
 	}
 
@@ -325,7 +330,7 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 		userSsh := &directory.UserSshPublicKey{}
 
 		if v, ok := sshConfig["expiration_time_usec"]; ok {
-			log.Printf("[DEBUG] Setting ssh %d expiration_time_usec: %s", i, int64(v.(int)))
+			log.Printf("[DEBUG] Setting ssh %d expiration_time_usec: %d", i, int64(v.(int)))
 			userSsh.ExpirationTimeUsec = int64(v.(int))
 		}
 		if v, ok := sshConfig["key"]; ok {
@@ -348,7 +353,7 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 			userPosix.Gecos = posixConfig["gecos"].(string)
 		}
 		if posixConfig["gid"] != 0 {
-			log.Printf("[DEBUG] Setting posix %d gid: %s", i, uint64(posixConfig["gid"].(int)))
+			log.Printf("[DEBUG] Setting posix %d gid: %d", i, uint64(posixConfig["gid"].(int)))
 			userPosix.Gid = uint64(posixConfig["gid"].(int))
 		}
 		if posixConfig["home_directory"] != "" {
@@ -364,11 +369,11 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 			userPosix.Shell = posixConfig["shell"].(string)
 		}
 		if posixConfig["primary"] != "" {
-			log.Printf("[DEBUG] Setting posix %d primary: %s", i, posixConfig["primary"].(bool))
+			log.Printf("[DEBUG] Setting posix %d primary: %t", i, posixConfig["primary"].(bool))
 			userPosix.Primary = posixConfig["primary"].(bool)
 		}
 		if posixConfig["uid"] != 0 {
-			log.Printf("[DEBUG] Setting posix %d uid: %s", i, uint64(posixConfig["uid"].(int)))
+			log.Printf("[DEBUG] Setting posix %d uid: %d", i, uint64(posixConfig["uid"].(int)))
 			userPosix.Uid = uint64(posixConfig["uid"].(int))
 		}
 		if posixConfig["username"] != "" {
@@ -387,17 +392,17 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	user.Name = userName
 
-	createdUser, err := config.directory.Users.Insert(user).Do()
+	createdUser, err := config.directory.Users.Insert(user).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error creating user: %s", err)
+		return diag.Errorf("Error creating user: %s", err)
 	}
 
   d.SetId(createdUser.Id)
 	log.Printf("[INFO] Created user: %s", createdUser.PrimaryEmail)
-	return resourceUserRead(d, meta)
+	return resourceUserRead(ctx, d, meta)
 }
 
-func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	user := &directory.User{}
@@ -456,7 +461,7 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("change_password_next_login") {
 		if v, ok := d.GetOk("change_password_next_login"); ok {
-			log.Printf("[DEBUG] Updating user change_password_next_login: %s", d.Get("change_password_next_login").(bool))
+			log.Printf("[DEBUG] Updating user change_password_next_login: %t", d.Get("change_password_next_login").(bool))
 			user.ChangePasswordAtNextLogin = v.(bool)
 		} else {
 			log.Printf("[DEBUG] Removing user change_password_next_login")
@@ -466,7 +471,7 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	if d.HasChange("include_in_global_list") {
 		if v, ok := d.GetOk("include_in_global_list"); ok {
-			log.Printf("[DEBUG] Updating user include_in_global_list: %s", d.Get("include_in_global_list").(bool))
+			log.Printf("[DEBUG] Updating user include_in_global_list: %t", d.Get("include_in_global_list").(bool))
 			user.IncludeInGlobalAddressList = v.(bool)
 		} else {
 			log.Printf("[DEBUG] Removing user include_in_global_list")
@@ -476,7 +481,7 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	if d.HasChange("is_ip_whitelisted") {
 		if v, ok := d.GetOk("is_ip_whitelisted"); ok {
-			log.Printf("[DEBUG] Updating user is_ip_whitelisted: %s", d.Get("is_ip_whitelisted").(bool))
+			log.Printf("[DEBUG] Updating user is_ip_whitelisted: %t", d.Get("is_ip_whitelisted").(bool))
 			user.IpWhitelisted = v.(bool)
 		} else {
 			log.Printf("[DEBUG] Removing user is_ip_whitelisted")
@@ -486,7 +491,7 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	if d.HasChange("is_suspended") {
 		if v, ok := d.GetOk("is_suspended"); ok {
-			log.Printf("[DEBUG] Updating user is_suspended: %s", d.Get("is_suspended").(bool))
+			log.Printf("[DEBUG] Updating user is_suspended: %t", d.Get("is_suspended").(bool))
 			user.Suspended = v.(bool)
 		} else {
 			log.Printf("[DEBUG] Removing user is_suspended")
@@ -503,7 +508,7 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 			userSsh := &directory.UserSshPublicKey{}
 
 			if v, ok := sshConfig["expiration_time_usec"]; ok {
-				log.Printf("[DEBUG] Setting ssh %d expiration_time_usec: %s", i, int64(v.(int)))
+				log.Printf("[DEBUG] Setting ssh %d expiration_time_usec: %d", i, int64(v.(int)))
 				userSsh.ExpirationTimeUsec = int64(v.(int))
 			}
 			if v, ok := sshConfig["key"]; ok {
@@ -528,7 +533,7 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 				userPosix.Gecos = posixConfig["gecos"].(string)
 			}
 			if posixConfig["gid"] != 0 {
-				log.Printf("[DEBUG] Setting posix %d gid: %s", i, uint64(posixConfig["gid"].(int)))
+				log.Printf("[DEBUG] Setting posix %d gid: %d", i, uint64(posixConfig["gid"].(int)))
 				userPosix.Gid = uint64(posixConfig["gid"].(int))
 			}
 			if posixConfig["home_directory"] != "" {
@@ -544,11 +549,11 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 				userPosix.Shell = posixConfig["shell"].(string)
 			}
 			if posixConfig["primary"] != "" {
-				log.Printf("[DEBUG] Setting posix %d primary: %s", i, posixConfig["primary"].(bool))
+				log.Printf("[DEBUG] Setting posix %d primary: %t", i, posixConfig["primary"].(bool))
 				userPosix.Primary = posixConfig["primary"].(bool)
 			}
 			if posixConfig["uid"] != 0 {
-				log.Printf("[DEBUG] Setting posix %d uid: %s", i, uint64(posixConfig["uid"].(int)))
+				log.Printf("[DEBUG] Setting posix %d uid: %d", i, uint64(posixConfig["uid"].(int)))
 				userPosix.Uid = uint64(posixConfig["uid"].(int))
 			}
 			if posixConfig["username"] != "" {
@@ -572,21 +577,21 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		user.NullFields = nullFields
 	}
 
-	updatedUser, err := config.directory.Users.Update(d.Id(), user).Do()
+	updatedUser, err := config.directory.Users.Update(d.Id(), user).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error updating user: %s", err)
+		return diag.Errorf("Error updating user: %s", err)
 	}
 
 	log.Printf("[INFO] Updated user: %s", updatedUser.PrimaryEmail)
-	return resourceUserRead(d, meta)
+	return resourceUserRead(ctx, d, meta)
 }
 
-func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	user, err := config.directory.Users.Get(d.Id()).Do()
+	user, err := config.directory.Users.Get(d.Id()).Context(ctx).Do()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(user.Id)
@@ -618,12 +623,12 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
-	err := config.directory.Users.Delete(d.Id()).Do()
+	err := config.directory.Users.Delete(d.Id()).Context(ctx).Do()
 	if err != nil {
-		return fmt.Errorf("Error deleting user: %s", err)
+		return diag.Errorf("Error deleting user: %s", err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,336 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vault "google.golang.org/api/vault/v1"
+)
+
+// resourceVaultExport starts an export and waits for Vault to finish it.
+// Exports cannot be changed once started, so every argument forces a new
+// export.
+func resourceVaultExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVaultExportCreate,
+		ReadContext:   resourceVaultExportRead,
+		DeleteContext: resourceVaultExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"matter_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// MAIL, DRIVE, GROUPS, HANGOUTS_CHAT, VOICE or CALENDAR
+			"corpus": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// ALL_DATA, HELD_DATA or UNPROCESSED_DATA
+			"data_scope": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ALL_DATA",
+				ForceNew: true,
+			},
+
+			// searches the accounts, the org unit or the entire organization
+			// when neither is set
+			"accounts": &schema.Schema{
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"org_unit_id"},
+			},
+
+			"org_unit_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"accounts"},
+			},
+
+			"terms": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"start_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC3339Time,
+			},
+
+			"end_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC3339Time,
+			},
+
+			// MBOX or PST, used by MAIL and GROUPS exports
+			"export_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "MBOX",
+				ForceNew: true,
+			},
+
+			// include access level information for DRIVE exports
+			"include_access_info": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			// ANY, US or EUROPE
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"export_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// the Cloud Storage objects holding the export
+			"files": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"md5_hash": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVaultQuery(d *schema.ResourceData) *vault.Query {
+	query := &vault.Query{
+		Corpus:       d.Get("corpus").(string),
+		DataScope:    d.Get("data_scope").(string),
+		SearchMethod: "ENTIRE_ORG",
+		Terms:        d.Get("terms").(string),
+		StartTime:    d.Get("start_time").(string),
+		EndTime:      d.Get("end_time").(string),
+	}
+
+	if v := d.Get("accounts").(*schema.Set); v.Len() > 0 {
+		query.SearchMethod = "ACCOUNT"
+		query.AccountInfo = &vault.AccountInfo{
+			Emails: convertStringList(v.List()),
+		}
+	}
+	if v, ok := d.GetOk("org_unit_id"); ok {
+		query.SearchMethod = "ORG_UNIT"
+		query.OrgUnitInfo = &vault.OrgUnitInfo{
+			OrgUnitId: v.(string),
+		}
+	}
+
+	return query
+}
+
+func expandVaultExportOptions(d *schema.ResourceData) *vault.ExportOptions {
+	options := &vault.ExportOptions{
+		Region: d.Get("region").(string),
+	}
+
+	switch d.Get("corpus").(string) {
+	case "MAIL":
+		options.MailOptions = &vault.MailExportOptions{
+			ExportFormat: d.Get("export_format").(string),
+		}
+	case "GROUPS":
+		options.GroupsOptions = &vault.GroupsExportOptions{
+			ExportFormat: d.Get("export_format").(string),
+		}
+	case "DRIVE":
+		options.DriveOptions = &vault.DriveExportOptions{
+			IncludeAccessInfo: d.Get("include_access_info").(bool),
+		}
+	}
+
+	return options
+}
+
+// flattenVaultExportOptions sets the arguments describing the export options.
+// Options of other corpora keep their defaults.
+func flattenVaultExportOptions(d *schema.ResourceData, options *vault.ExportOptions) {
+	if options == nil {
+		return
+	}
+
+	d.Set("region", options.Region)
+	if options.MailOptions != nil {
+		d.Set("export_format", options.MailOptions.ExportFormat)
+	}
+	if options.GroupsOptions != nil {
+		d.Set("export_format", options.GroupsOptions.ExportFormat)
+	}
+	if options.DriveOptions != nil {
+		d.Set("include_access_info", options.DriveOptions.IncludeAccessInfo)
+	}
+}
+
+func flattenVaultExportFiles(sink *vault.CloudStorageSink) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	if sink == nil {
+		return result
+	}
+	for _, file := range sink.Files {
+		result = append(result, map[string]interface{}{
+			"bucket_name": file.BucketName,
+			"object_name": file.ObjectName,
+			"size":        int(file.Size),
+			"md5_hash":    file.Md5Hash,
+		})
+	}
+	return result
+}
+
+func resourceVaultExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matterID := d.Get("matter_id").(string)
+	export := &vault.Export{
+		Name:          d.Get("name").(string),
+		Query:         expandVaultQuery(d),
+		ExportOptions: expandVaultExportOptions(d),
+	}
+
+	createdExport, err := vaultSvc.Matters.Exports.Create(matterID, export).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating vault export: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", matterID, createdExport.Id))
+	log.Printf("[INFO] Created vault export: %s", createdExport.Name)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"EXPORT_STATUS_UNSPECIFIED", "IN_PROGRESS"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			export, err := vaultSvc.Matters.Exports.Get(matterID, createdExport.Id).Context(ctx).Do()
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Vault export %s is %s", export.Id, export.Status)
+			return export, export.Status, nil
+		},
+		Timeout:    config.operationTimeout(d, schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for vault export %s: %s", d.Id(), err)
+	}
+
+	return resourceVaultExportRead(ctx, d, meta)
+}
+
+func resourceVaultExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matterID, exportID := parts[0], parts[1]
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	export, err := vaultSvc.Matters.Exports.Get(matterID, exportID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("vault export %s", d.Id())))
+	}
+
+	d.Set("matter_id", matterID)
+	d.Set("export_id", export.Id)
+	d.Set("name", export.Name)
+	d.Set("status", export.Status)
+	d.Set("files", flattenVaultExportFiles(export.CloudStorageSink))
+	flattenVaultQuery(d, export.Query)
+	flattenVaultExportOptions(d, export.ExportOptions)
+
+	return nil
+}
+
+func resourceVaultExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = vaultSvc.Matters.Exports.Delete(d.Get("matter_id").(string), d.Get("export_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting vault export: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,317 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vault "google.golang.org/api/vault/v1"
+)
+
+func resourceVaultHold() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVaultHoldCreate,
+		ReadContext:   resourceVaultHoldRead,
+		UpdateContext: resourceVaultHoldUpdate,
+		DeleteContext: resourceVaultHoldDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"matter_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// MAIL, DRIVE, GROUPS, HANGOUTS_CHAT, VOICE or CALENDAR
+			"corpus": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// a hold covers either an org unit or individual accounts, which
+			// cannot be changed afterwards
+			"org_unit_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"accounts"},
+			},
+
+			"accounts": &schema.Schema{
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"org_unit_id"},
+			},
+
+			// search terms, only used by MAIL and GROUPS holds
+			"terms": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// only used by MAIL and GROUPS holds
+			"start_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			// only used by MAIL and GROUPS holds
+			"end_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			// only used by DRIVE holds
+			"include_shared_drive_files": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"hold_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandVaultHoldQuery(d *schema.ResourceData) *vault.CorpusQuery {
+	terms := d.Get("terms").(string)
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+
+	switch d.Get("corpus").(string) {
+	case "MAIL":
+		return &vault.CorpusQuery{
+			MailQuery: &vault.HeldMailQuery{
+				Terms:     terms,
+				StartTime: startTime,
+				EndTime:   endTime,
+			},
+		}
+	case "GROUPS":
+		return &vault.CorpusQuery{
+			GroupsQuery: &vault.HeldGroupsQuery{
+				Terms:     terms,
+				StartTime: startTime,
+				EndTime:   endTime,
+			},
+		}
+	case "DRIVE":
+		return &vault.CorpusQuery{
+			DriveQuery: &vault.HeldDriveQuery{
+				IncludeSharedDriveFiles: d.Get("include_shared_drive_files").(bool),
+			},
+		}
+	}
+	return nil
+}
+
+func flattenVaultHoldQuery(d *schema.ResourceData, query *vault.CorpusQuery) {
+	if query == nil {
+		return
+	}
+	if query.MailQuery != nil {
+		d.Set("terms", query.MailQuery.Terms)
+		d.Set("start_time", query.MailQuery.StartTime)
+		d.Set("end_time", query.MailQuery.EndTime)
+	}
+	if query.GroupsQuery != nil {
+		d.Set("terms", query.GroupsQuery.Terms)
+		d.Set("start_time", query.GroupsQuery.StartTime)
+		d.Set("end_time", query.GroupsQuery.EndTime)
+	}
+	if query.DriveQuery != nil {
+		d.Set("include_shared_drive_files", query.DriveQuery.IncludeSharedDriveFiles)
+	}
+}
+
+func resourceVaultHoldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matterID := d.Get("matter_id").(string)
+	hold := &vault.Hold{
+		Name:   d.Get("name").(string),
+		Corpus: d.Get("corpus").(string),
+		Query:  expandVaultHoldQuery(d),
+	}
+
+	if v, ok := d.GetOk("org_unit_id"); ok {
+		log.Printf("[DEBUG] Setting vault hold org_unit_id: %s", v.(string))
+		hold.OrgUnit = &vault.HeldOrgUnit{
+			OrgUnitId: v.(string),
+		}
+	}
+	for _, v := range d.Get("accounts").(*schema.Set).List() {
+		hold.Accounts = append(hold.Accounts, &vault.HeldAccount{
+			Email: v.(string),
+		})
+	}
+
+	createdHold, err := vaultSvc.Matters.Holds.Create(matterID, hold).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating vault hold: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", matterID, createdHold.HoldId))
+	log.Printf("[INFO] Created vault hold: %s", createdHold.Name)
+	return resourceVaultHoldRead(ctx, d, meta)
+}
+
+func resourceVaultHoldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matterID := d.Get("matter_id").(string)
+	holdID := d.Get("hold_id").(string)
+
+	if d.HasChange("accounts") {
+		// Accounts are removed by ID, so look up the IDs of the held accounts
+		current, err := vaultSvc.Matters.Holds.Accounts.List(matterID, holdID).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error listing vault hold accounts: %s", err)
+		}
+
+		old, new := d.GetChange("accounts")
+		add := convertStringList(new.(*schema.Set).Difference(old.(*schema.Set)).List())
+		remove := old.(*schema.Set).Difference(new.(*schema.Set))
+
+		var removeIDs []string
+		for _, account := range current.Accounts {
+			if remove.Contains(account.Email) {
+				removeIDs = append(removeIDs, account.AccountId)
+			}
+		}
+
+		if len(removeIDs) > 0 {
+			log.Printf("[DEBUG] Removing %d accounts from vault hold %s", len(removeIDs), d.Id())
+			_, err := vaultSvc.Matters.Holds.RemoveHeldAccounts(matterID, holdID, &vault.RemoveHeldAccountsRequest{
+				AccountIds: removeIDs,
+			}).Context(ctx).Do()
+			if err != nil {
+				return diag.Errorf("Error removing vault hold accounts: %s", err)
+			}
+		}
+		if len(add) > 0 {
+			log.Printf("[DEBUG] Adding %d accounts to vault hold %s", len(add), d.Id())
+			_, err := vaultSvc.Matters.Holds.AddHeldAccounts(matterID, holdID, &vault.AddHeldAccountsRequest{
+				Emails: add,
+			}).Context(ctx).Do()
+			if err != nil {
+				return diag.Errorf("Error adding vault hold accounts: %s", err)
+			}
+		}
+	}
+
+	if d.HasChange("name") || d.HasChange("terms") || d.HasChange("start_time") ||
+		d.HasChange("end_time") || d.HasChange("include_shared_drive_files") {
+		hold := &vault.Hold{
+			Name:   d.Get("name").(string),
+			Corpus: d.Get("corpus").(string),
+			Query:  expandVaultHoldQuery(d),
+		}
+		if v, ok := d.GetOk("org_unit_id"); ok {
+			hold.OrgUnit = &vault.HeldOrgUnit{
+				OrgUnitId: v.(string),
+			}
+		}
+
+		log.Printf("[DEBUG] Updating vault hold: %s", hold.Name)
+		_, err := vaultSvc.Matters.Holds.Update(matterID, holdID, hold).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating vault hold: %s", err)
+		}
+	}
+
+	log.Printf("[INFO] Updated vault hold: %s", d.Id())
+	return resourceVaultHoldRead(ctx, d, meta)
+}
+
+func resourceVaultHoldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matterID, holdID := parts[0], parts[1]
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hold, err := vaultSvc.Matters.Holds.Get(matterID, holdID).View("FULL_HOLD").Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("vault hold %s", d.Id())))
+	}
+
+	d.Set("matter_id", matterID)
+	d.Set("hold_id", hold.HoldId)
+	d.Set("name", hold.Name)
+	d.Set("corpus", hold.Corpus)
+	d.Set("update_time", hold.UpdateTime)
+	if hold.OrgUnit != nil {
+		d.Set("org_unit_id", hold.OrgUnit.OrgUnitId)
+	}
+
+	accounts := make([]string, 0, len(hold.Accounts))
+	for _, account := range hold.Accounts {
+		accounts = append(accounts, account.Email)
+	}
+	d.Set("accounts", accounts)
+
+	flattenVaultHoldQuery(d, hold.Query)
+
+	return nil
+}
+
+func resourceVaultHoldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = vaultSvc.Matters.Holds.Delete(d.Get("matter_id").(string), d.Get("hold_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting vault hold: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
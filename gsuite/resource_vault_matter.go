@@ -0,0 +1,181 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vault "google.golang.org/api/vault/v1"
+)
+
+func resourceVaultMatter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVaultMatterCreate,
+		ReadContext:   resourceVaultMatterRead,
+		UpdateContext: resourceVaultMatterUpdate,
+		DeleteContext: resourceVaultMatterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// OPEN or CLOSED
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "OPEN",
+			},
+
+			"matter_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func updateVaultMatterState(ctx context.Context, vaultSvc *vault.Service, matterID, state string) error {
+	var err error
+	switch state {
+	case "OPEN":
+		log.Printf("[DEBUG] Reopening vault matter %s", matterID)
+		_, err = vaultSvc.Matters.Reopen(matterID, &vault.ReopenMatterRequest{}).Context(ctx).Do()
+	case "CLOSED":
+		log.Printf("[DEBUG] Closing vault matter %s", matterID)
+		_, err = vaultSvc.Matters.Close(matterID, &vault.CloseMatterRequest{}).Context(ctx).Do()
+	default:
+		return fmt.Errorf("Unsupported vault matter state %q", state)
+	}
+	if err != nil {
+		return fmt.Errorf("Error updating vault matter state: %s", err)
+	}
+	return nil
+}
+
+func resourceVaultMatterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matter := &vault.Matter{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	createdMatter, err := vaultSvc.Matters.Create(matter).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating vault matter: %s", err)
+	}
+
+	d.SetId(createdMatter.MatterId)
+	log.Printf("[INFO] Created vault matter: %s", createdMatter.Name)
+
+	if state := d.Get("state").(string); state != createdMatter.State {
+		if err := updateVaultMatterState(ctx, vaultSvc, createdMatter.MatterId, state); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVaultMatterRead(ctx, d, meta)
+}
+
+func resourceVaultMatterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") {
+		// Update replaces the matter, so both fields are always sent
+		matter := &vault.Matter{
+			Name:            d.Get("name").(string),
+			Description:     d.Get("description").(string),
+			ForceSendFields: []string{"Description"},
+		}
+
+		log.Printf("[DEBUG] Updating vault matter: %s", matter.Name)
+		_, err := vaultSvc.Matters.Update(d.Id(), matter).Context(ctx).Do()
+		if err != nil {
+			return diag.Errorf("Error updating vault matter: %s", err)
+		}
+	}
+
+	if d.HasChange("state") {
+		if err := updateVaultMatterState(ctx, vaultSvc, d.Id(), d.Get("state").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Updated vault matter: %s", d.Id())
+	return resourceVaultMatterRead(ctx, d, meta)
+}
+
+func resourceVaultMatterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matter, err := vaultSvc.Matters.Get(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("vault matter %s", d.Id())))
+	}
+
+	if matter.State == "DELETED" {
+		log.Printf("[WARN] Removing vault matter %s because it was deleted", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("matter_id", matter.MatterId)
+	d.Set("name", matter.Name)
+	d.Set("description", matter.Description)
+	d.Set("state", matter.State)
+
+	return nil
+}
+
+// resourceVaultMatterDelete closes open matters first, since only closed
+// matters can be deleted.
+func resourceVaultMatterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("state").(string) == "OPEN" {
+		if err := updateVaultMatterState(ctx, vaultSvc, d.Id(), "CLOSED"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err = vaultSvc.Matters.Delete(d.Id()).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting vault matter: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
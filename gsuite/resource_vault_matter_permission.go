@@ -0,0 +1,152 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vault "google.golang.org/api/vault/v1"
+)
+
+func resourceVaultMatterPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVaultMatterPermissionCreate,
+		ReadContext:   resourceVaultMatterPermissionRead,
+		DeleteContext: resourceVaultMatterPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"matter_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the user's primary email address or unique ID
+			"account": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// COLLABORATOR or OWNER
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "COLLABORATOR",
+				ForceNew: true,
+			},
+
+			// notify the account when the permission is added
+			"send_emails": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"account_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVaultMatterPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Vault only knows accounts by their directory ID
+	account := d.Get("account").(string)
+	user, err := config.directory.Users.Get(account).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error reading user %s: %s", account, err)
+	}
+
+	matterID := d.Get("matter_id").(string)
+	permission, err := vaultSvc.Matters.AddPermissions(matterID, &vault.AddMatterPermissionsRequest{
+		MatterPermission: &vault.MatterPermission{
+			AccountId: user.Id,
+			Role:      d.Get("role").(string),
+		},
+		SendEmails: d.Get("send_emails").(bool),
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating vault matter permission: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", matterID, permission.AccountId))
+	log.Printf("[INFO] Created vault matter permission for %s on %s", account, matterID)
+	return resourceVaultMatterPermissionRead(ctx, d, meta)
+}
+
+func resourceVaultMatterPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matterID, accountID := parts[0], parts[1]
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matter, err := vaultSvc.Matters.Get(matterID).View("FULL").Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("vault matter permission %s", d.Id())))
+	}
+
+	var permission *vault.MatterPermission
+	for _, p := range matter.MatterPermissions {
+		if p.AccountId == accountID {
+			permission = p
+		}
+	}
+
+	if permission == nil || matter.State == "DELETED" {
+		log.Printf("[WARN] Removing vault matter permission %s because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("matter_id", matterID)
+	d.Set("account_id", permission.AccountId)
+	d.Set("role", permission.Role)
+	if _, ok := d.GetOk("account"); !ok {
+		d.Set("account", permission.AccountId)
+	}
+
+	return nil
+}
+
+func resourceVaultMatterPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = vaultSvc.Matters.RemovePermissions(d.Get("matter_id").(string), &vault.RemoveMatterPermissionsRequest{
+		AccountId: d.Get("account_id").(string),
+	}).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting vault matter permission: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,192 @@
+package gsuite
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vault "google.golang.org/api/vault/v1"
+)
+
+// resourceVaultSavedQuery manages a search saved under a matter. Saved
+// queries cannot be updated, so every argument forces a new one.
+func resourceVaultSavedQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVaultSavedQueryCreate,
+		ReadContext:   resourceVaultSavedQueryRead,
+		DeleteContext: resourceVaultSavedQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"matter_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// MAIL, DRIVE, GROUPS, HANGOUTS_CHAT, VOICE or CALENDAR
+			"corpus": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// ALL_DATA, HELD_DATA or UNPROCESSED_DATA
+			"data_scope": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ALL_DATA",
+				ForceNew: true,
+			},
+
+			// searches the accounts, the org unit or the entire organization
+			// when neither is set
+			"accounts": &schema.Schema{
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"org_unit_id"},
+			},
+
+			"org_unit_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"accounts"},
+			},
+
+			"terms": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"start_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"end_time": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"saved_query_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func flattenVaultQuery(d *schema.ResourceData, query *vault.Query) {
+	if query == nil {
+		return
+	}
+
+	d.Set("corpus", query.Corpus)
+	d.Set("data_scope", query.DataScope)
+	d.Set("terms", query.Terms)
+	d.Set("start_time", query.StartTime)
+	d.Set("end_time", query.EndTime)
+
+	if query.AccountInfo != nil {
+		d.Set("accounts", query.AccountInfo.Emails)
+	}
+	if query.OrgUnitInfo != nil {
+		d.Set("org_unit_id", query.OrgUnitInfo.OrgUnitId)
+	}
+}
+
+func resourceVaultSavedQueryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matterID := d.Get("matter_id").(string)
+	savedQuery := &vault.SavedQuery{
+		DisplayName: d.Get("display_name").(string),
+		Query:       expandVaultQuery(d),
+	}
+
+	createdSavedQuery, err := vaultSvc.Matters.SavedQueries.Create(matterID, savedQuery).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error creating vault saved query: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", matterID, createdSavedQuery.SavedQueryId))
+	log.Printf("[INFO] Created vault saved query: %s", createdSavedQuery.DisplayName)
+	return resourceVaultSavedQueryRead(ctx, d, meta)
+}
+
+func resourceVaultSavedQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	parts, err := splitID(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matterID, savedQueryID := parts[0], parts[1]
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	savedQuery, err := vaultSvc.Matters.SavedQueries.Get(matterID, savedQueryID).Context(ctx).Do()
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("vault saved query %s", d.Id())))
+	}
+
+	d.Set("matter_id", matterID)
+	d.Set("saved_query_id", savedQuery.SavedQueryId)
+	d.Set("display_name", savedQuery.DisplayName)
+	d.Set("create_time", savedQuery.CreateTime)
+	flattenVaultQuery(d, savedQuery.Query)
+
+	return nil
+}
+
+func resourceVaultSavedQueryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vaultSvc, err := config.vaultService()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = vaultSvc.Matters.SavedQueries.Delete(d.Get("matter_id").(string), d.Get("saved_query_id").(string)).Context(ctx).Do()
+	if err != nil {
+		return diag.Errorf("Error deleting vault saved query: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
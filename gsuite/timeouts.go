@@ -0,0 +1,116 @@
+package gsuite
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultOperationTimeout is the timeout helper/schema uses for operations
+// without a default of their own.
+const defaultOperationTimeout = 20 * time.Minute
+
+// declareTimeouts adds timeouts for the operations of resources that don't
+// declare them, so that every resource accepts a timeouts block. Operations
+// set their deadline themselves, so that the provider's default_timeouts can
+// replace the defaults.
+func declareTimeouts(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if r.Timeouts == nil {
+			r.Timeouts = &schema.ResourceTimeout{}
+		}
+		if r.CreateContext != nil {
+			if r.Timeouts.Create == nil {
+				r.Timeouts.Create = schema.DefaultTimeout(defaultOperationTimeout)
+			}
+			r.CreateWithoutTimeout = withOperationTimeout(schema.TimeoutCreate, r.CreateContext)
+			r.CreateContext = nil
+		}
+		if r.ReadContext != nil {
+			if r.Timeouts.Read == nil {
+				r.Timeouts.Read = schema.DefaultTimeout(defaultOperationTimeout)
+			}
+			r.ReadWithoutTimeout = withOperationTimeout(schema.TimeoutRead, r.ReadContext)
+			r.ReadContext = nil
+		}
+		if r.UpdateContext != nil {
+			if r.Timeouts.Update == nil {
+				r.Timeouts.Update = schema.DefaultTimeout(defaultOperationTimeout)
+			}
+			r.UpdateWithoutTimeout = withOperationTimeout(schema.TimeoutUpdate, r.UpdateContext)
+			r.UpdateContext = nil
+		}
+		if r.DeleteContext != nil {
+			if r.Timeouts.Delete == nil {
+				r.Timeouts.Delete = schema.DefaultTimeout(defaultOperationTimeout)
+			}
+			r.DeleteWithoutTimeout = withOperationTimeout(schema.TimeoutDelete, r.DeleteContext)
+			r.DeleteContext = nil
+		}
+	}
+}
+
+// operationFunc is the signature shared by the CRUD functions.
+type operationFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// withOperationTimeout runs the operation with the deadline of
+// operationTimeout.
+func withOperationTimeout(key string, f operationFunc) operationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, cancel := context.WithTimeout(ctx, meta.(*Config).operationTimeout(d, key))
+		defer cancel()
+		return f(ctx, d, meta)
+	}
+}
+
+// operationTimeout returns the timeout of an operation: the one of the
+// resource's timeouts block, or else the one of the provider's
+// default_timeouts, or else the resource's default.
+func (c *Config) operationTimeout(d *schema.ResourceData, key string) time.Duration {
+	if v, ok := c.defaultTimeouts[key]; ok && !hasConfiguredTimeout(d, key) {
+		return v
+	}
+	return d.Timeout(key)
+}
+
+// hasConfiguredTimeout returns whether the timeouts block of the resource sets
+// the timeout of the operation. Deletes and refreshes have no configuration and
+// look at the state instead.
+func hasConfiguredTimeout(d *schema.ResourceData, key string) bool {
+	for _, v := range []cty.Value{d.GetRawConfig(), d.GetRawState()} {
+		if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute(schema.TimeoutsConfigKey) {
+			continue
+		}
+		timeouts := v.GetAttr(schema.TimeoutsConfigKey)
+		if timeouts.IsNull() || !timeouts.IsKnown() {
+			return false
+		}
+		for _, k := range []string{key, schema.TimeoutDefault} {
+			if timeouts.Type().HasAttribute(k) && !timeouts.GetAttr(k).IsNull() {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// expandDefaultTimeouts reads the provider's default_timeouts block. The
+// durations are validated by the schema.
+func expandDefaultTimeouts(v []interface{}) map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+	if len(v) == 0 || v[0] == nil {
+		return timeouts
+	}
+
+	for key, duration := range v[0].(map[string]interface{}) {
+		if duration.(string) == "" {
+			continue
+		}
+		timeouts[key], _ = time.ParseDuration(duration.(string))
+	}
+	return timeouts
+}
@@ -0,0 +1,72 @@
+package gsuite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestHasConfiguredTimeout(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+	null := cty.NullVal(cty.String)
+	timeouts := func(create, deflt cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"create":  create,
+			"delete":  null,
+			"default": deflt,
+		})
+	}
+	object := func(timeouts cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":       null,
+			"name":     cty.StringVal("a"),
+			"timeouts": timeouts,
+		})
+	}
+	noTimeouts := object(cty.NullVal(timeouts(null, null).Type()))
+
+	cases := []struct {
+		name   string
+		config cty.Value
+		state  cty.Value
+		key    string
+		want   bool
+	}{
+		{name: "no timeouts block", config: noTimeouts, key: schema.TimeoutCreate, want: false},
+		{name: "timeout set", config: object(timeouts(cty.StringVal("20m"), null)), key: schema.TimeoutCreate, want: true},
+		{name: "other timeout set", config: object(timeouts(cty.StringVal("20m"), null)), key: schema.TimeoutDelete, want: false},
+		{name: "default set", config: object(timeouts(null, cty.StringVal("1h"))), key: schema.TimeoutDelete, want: true},
+		{name: "removed from the configuration", config: noTimeouts, state: object(timeouts(cty.StringVal("20m"), null)), key: schema.TimeoutCreate, want: false},
+		{name: "set in the state only", state: object(timeouts(cty.StringVal("20m"), null)), key: schema.TimeoutCreate, want: true},
+		{name: "neither", key: schema.TimeoutCreate, want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &terraform.InstanceState{ID: "id"}
+			if tc.config != cty.NilVal {
+				state.RawConfig = tc.config
+			}
+			if tc.state != cty.NilVal {
+				state.RawState = tc.state
+			}
+			if got := hasConfiguredTimeout(r.Data(state), tc.key); got != tc.want {
+				t.Errorf("hasConfiguredTimeout() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package gsuite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultRetryableStatusCodes are retried when retryable_status_codes isn't
+// set. Admin SDK rate limit errors are also retried, although they are
+// returned as 403s.
+var defaultRetryableStatusCodes = []int{429, 500, 502, 503, 504}
+
+// retryTransport retries failed requests with exponential backoff.
+type retryTransport struct {
+	transport http.RoundTripper
+
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	retryableCodes map[int]bool
+}
+
+func newRetryTransport(transport http.RoundTripper, c *Config) *retryTransport {
+	retryableCodes := map[int]bool{}
+	for _, code := range c.RetryableStatusCodes {
+		retryableCodes[code] = true
+	}
+
+	return &retryTransport{
+		transport:      transport,
+		maxRetries:     c.MaxRetries,
+		initialBackoff: c.RetryInitialBackoff,
+		maxBackoff:     c.RetryMaxBackoff,
+		retryableCodes: retryableCodes,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.initialBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := t.transport.RoundTrip(req)
+		if attempt >= t.maxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		// Requests with a body can only be sent again when it can be read
+		// again, which is the case for the bodies of the generated clients.
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.WithContext(req.Context())
+			req.Body = body
+		}
+
+		wait := backoff
+		if resp != nil {
+			if retryAfter, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil && time.Duration(retryAfter)*time.Second > wait {
+				wait = time.Duration(retryAfter) * time.Second
+			}
+			log.Printf("[WARN] %s %s returned %d, retrying in %s (%d/%d)", req.Method, req.URL, resp.StatusCode, wait, attempt+1, t.maxRetries)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[WARN] %s %s failed: %s, retrying in %s (%d/%d)", req.Method, req.URL, err, wait, attempt+1, t.maxRetries)
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		backoff *= 2
+		if backoff > t.maxBackoff {
+			backoff = t.maxBackoff
+		}
+	}
+}
+
+// retryable returns whether the request failed in a way worth retrying. Rate
+// limited requests weren't processed and are always retried, other failures
+// only for idempotent requests, since a create that failed that way may still
+// have succeeded.
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil && isIdempotentRequest(req)
+	}
+	if t.retryableCodes[resp.StatusCode] {
+		return resp.StatusCode == http.StatusTooManyRequests || isIdempotentRequest(req)
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	// Tell rate limit errors apart from permission errors, keeping the body
+	// for the caller.
+	body, readErr := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return false
+	}
+	return strings.Contains(string(body), "rateLimitExceeded") || strings.Contains(string(body), "userRateLimitExceeded")
+}
+
+// isIdempotentRequest returns whether sending the request twice has the same
+// effect as sending it once, either because of its method or because it
+// carries a request ID the API deduplicates requests with.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return req.URL.Query().Get("requestId") != ""
+}
+
+// rateLimiter is a token bucket shared by all clients of the provider, which
+// allows bursts of up to a second worth of requests.
+type rateLimiter struct {
+	mu        sync.Mutex
+	tokens    float64
+	capacity  float64
+	perSecond float64
+	last      time.Time
+}
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	perSecond := float64(requestsPerMinute) / 60
+	capacity := math.Max(1, perSecond)
+	return &rateLimiter{
+		tokens:    capacity,
+		capacity:  capacity,
+		perSecond: perSecond,
+		last:      time.Now(),
+	}
+}
+
+// wait blocks until a request may be sent.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.capacity, l.tokens+now.Sub(l.last).Seconds()*l.perSecond)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / l.perSecond * float64(time.Second))
+		l.mu.Unlock()
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// rateLimitedTransport holds back Directory API requests to stay within the
+// rate of the limiter.
+type rateLimitedTransport struct {
+	transport http.RoundTripper
+	limiter   *rateLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "/admin/directory/") {
+		if err := t.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// stopContextTransport gives requests sent without a context of their own,
+// such as token requests, the context canceled when Terraform stops the
+// provider. API calls carry the context of the operation sending them, which
+// Terraform cancels on interrupt and when the operation times out.
+type stopContextTransport struct {
+	transport http.RoundTripper
+	ctx       context.Context
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *stopContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Done() == nil {
+		req = req.WithContext(t.ctx)
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// headerTransport adds headers to every request, such as the request reason
+// for audit logs.
+type headerTransport struct {
+	transport http.RoundTripper
+	headers   http.Header
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the request they are given
+	req = req.WithContext(req.Context())
+	req.Header = cloneHeader(req.Header)
+	for name, values := range t.headers {
+		req.Header[name] = values
+	}
+	return t.transport.RoundTrip(req)
+}
+
+func cloneHeader(header http.Header) http.Header {
+	clone := make(http.Header, len(header))
+	for name, values := range header {
+		clone[name] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+// readOnlyPOSTSuffixes are the ends of the paths of POST requests that only
+// read data, which are allowed in read-only mode.
+var readOnlyPOSTSuffixes = []string{
+	// Chrome Policy
+	":resolve",
+	":listGroupPriorityOrdering",
+	// Site Verification
+	"/siteVerification/v1/token",
+	// IAM Credentials, to impersonate service accounts
+	":signJwt",
+}
+
+// readOnlyTransport refuses requests that could change data, so that a
+// provider in read-only mode can plan and refresh but never apply changes.
+type readOnlyTransport struct {
+	transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyRequest(req) {
+		log.Printf("[WARN] Refusing %s %s in read-only mode", req.Method, req.URL)
+		return nil, fmt.Errorf("refusing %s %s: the provider is in read_only mode", req.Method, req.URL.Path)
+	}
+	return t.transport.RoundTrip(req)
+}
+
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	case "POST":
+		for _, suffix := range readOnlyPOSTSuffixes {
+			if strings.HasSuffix(req.URL.Path, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// quotaProgressInterval is how often waiting for the daily quota is logged.
+const quotaProgressInterval = 10 * time.Minute
+
+// quotaWaitTransport waits for daily quotas to reset when a request exceeds
+// them, instead of failing halfway through an apply. Waiting ends at the
+// deadline, after which quota errors are returned as usual.
+type quotaWaitTransport struct {
+	transport http.RoundTripper
+	deadline  time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *quotaWaitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for {
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || !isDailyQuotaError(resp) {
+			return resp, err
+		}
+
+		reset := nextQuotaReset(time.Now())
+		if reset.After(t.deadline) {
+			log.Printf("[WARN] Daily quota exceeded by %s %s, not waiting for its reset at %s past the deadline", req.Method, req.URL, reset)
+			return resp, err
+		}
+
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.WithContext(req.Context())
+			req.Body = body
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		log.Printf("[WARN] Daily quota exceeded by %s %s, waiting for its reset at %s", req.Method, req.URL, reset)
+		if err := waitUntil(req.Context(), reset); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isDailyQuotaError returns whether a response reports an exhausted daily
+// quota, keeping the body for the caller.
+func isDailyQuotaError(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+
+	body, readErr := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return false
+	}
+	return strings.Contains(string(body), "dailyLimitExceeded") || strings.Contains(string(body), "quotaExceeded")
+}
+
+// nextQuotaReset returns when daily quotas reset next, which is at midnight
+// Pacific Time, with a minute of margin.
+func nextQuotaReset(now time.Time) time.Time {
+	pacific, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		pacific = time.FixedZone("PST", -8*60*60)
+	}
+
+	now = now.In(pacific)
+	midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, pacific)
+	return midnight.Add(time.Minute)
+}
+
+// waitUntil blocks until the given time, logging the progress.
+func waitUntil(ctx context.Context, until time.Time) error {
+	for {
+		remaining := time.Until(until)
+		if remaining <= 0 {
+			return nil
+		}
+
+		wait := remaining
+		if wait > quotaProgressInterval {
+			wait = quotaProgressInterval
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if remaining > wait {
+			log.Printf("[INFO] Waiting %s more for the daily quota to reset", (remaining - wait).Round(time.Minute))
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package gsuite
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func newTestRequest(t *testing.T, ctx context.Context, method, url string) *http.Request {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func newTestResponse(code int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestRetryTransportRetryable(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		url    string
+		code   int
+		body   string
+		err    error
+		want   bool
+	}{
+		{name: "network error GET", method: "GET", err: errors.New("connection reset"), want: true},
+		{name: "network error DELETE", method: "DELETE", err: errors.New("connection reset"), want: true},
+		{name: "network error POST", method: "POST", err: errors.New("connection reset"), want: false},
+		{name: "network error POST with request ID", method: "POST", url: "?requestId=abc", err: errors.New("connection reset"), want: true},
+		{name: "network error canceled", ctx: canceled, method: "GET", err: context.Canceled, want: false},
+		{name: "503 GET", method: "GET", code: 503, want: true},
+		{name: "503 PUT", method: "PUT", code: 503, want: true},
+		{name: "503 POST", method: "POST", code: 503, want: false},
+		{name: "503 PATCH", method: "PATCH", code: 503, want: false},
+		{name: "429 POST", method: "POST", code: 429, want: true},
+		{name: "404 GET", method: "GET", code: 404, want: false},
+		{name: "403 rate limit POST", method: "POST", code: 403, body: `{"error":{"errors":[{"reason":"rateLimitExceeded"}]}}`, want: true},
+		{name: "403 user rate limit GET", method: "GET", code: 403, body: `{"error":{"errors":[{"reason":"userRateLimitExceeded"}]}}`, want: true},
+		{name: "403 permission GET", method: "GET", code: 403, body: `{"error":{"errors":[{"reason":"forbidden"}]}}`, want: false},
+	}
+
+	transport := &retryTransport{retryableCodes: map[int]bool{}}
+	for _, code := range defaultRetryableStatusCodes {
+		transport.retryableCodes[code] = true
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			req := newTestRequest(t, ctx, tc.method, "https://admin.googleapis.com/admin/directory/v1/users"+tc.url)
+
+			var resp *http.Response
+			if tc.err == nil {
+				resp = newTestResponse(tc.code, tc.body)
+			}
+
+			if got := transport.retryable(req, resp, tc.err); got != tc.want {
+				t.Errorf("retryable() = %t, want %t", got, tc.want)
+			}
+			if resp != nil {
+				body, err := ioutil.ReadAll(resp.Body)
+				if err != nil || string(body) != tc.body {
+					t.Errorf("body = %q, %v, want %q", body, err, tc.body)
+				}
+			}
+		})
+	}
+}
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	cases := []struct {
+		method string
+		url    string
+		want   bool
+	}{
+		{"GET", "https://admin.googleapis.com/admin/directory/v1/users", true},
+		{"HEAD", "https://admin.googleapis.com/admin/directory/v1/users", true},
+		{"OPTIONS", "https://admin.googleapis.com/admin/directory/v1/users", true},
+		{"POST", "https://admin.googleapis.com/admin/directory/v1/users", false},
+		{"PUT", "https://admin.googleapis.com/admin/directory/v1/users/jane@example.com", false},
+		{"PATCH", "https://admin.googleapis.com/admin/directory/v1/users/jane@example.com", false},
+		{"DELETE", "https://admin.googleapis.com/admin/directory/v1/users/jane@example.com", false},
+		{"POST", "https://chromepolicy.googleapis.com/v1/customers/my_customer/policies:resolve", true},
+		{"POST", "https://chromepolicy.googleapis.com/v1/customers/my_customer/policies/orgunits:batchModify", false},
+		{"POST", "https://www.googleapis.com/siteVerification/v1/token", true},
+		{"POST", "https://www.googleapis.com/siteVerification/v1/webResource", false},
+		{"POST", "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/sa@example.iam.gserviceaccount.com:signJwt", true},
+	}
+
+	for _, tc := range cases {
+		req := newTestRequest(t, context.Background(), tc.method, tc.url)
+		if got := isReadOnlyRequest(req); got != tc.want {
+			t.Errorf("isReadOnlyRequest(%s %s) = %t, want %t", tc.method, tc.url, got, tc.want)
+		}
+	}
+}
+
+func TestNextQuotaReset(t *testing.T) {
+	cases := []struct {
+		now  string
+		want string
+	}{
+		// 02:00 PST
+		{"2024-03-05T10:00:00Z", "2024-03-06T08:01:00Z"},
+		// 23:59 PST
+		{"2024-03-06T07:59:00Z", "2024-03-06T08:01:00Z"},
+		// 00:00:30 PST, right after a reset
+		{"2024-03-06T08:00:30Z", "2024-03-07T08:01:00Z"},
+		// summer time
+		{"2024-07-01T19:00:00Z", "2024-07-02T07:01:00Z"},
+		// the day summer time starts
+		{"2024-03-09T20:00:00Z", "2024-03-10T08:01:00Z"},
+		// the day summer time ends
+		{"2024-11-03T19:00:00Z", "2024-11-04T08:01:00Z"},
+	}
+
+	for _, tc := range cases {
+		now, err := time.Parse(time.RFC3339, tc.now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := time.Parse(time.RFC3339, tc.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := nextQuotaReset(now); !got.Equal(want) {
+			t.Errorf("nextQuotaReset(%s) = %s, want %s", tc.now, got.UTC().Format(time.RFC3339), tc.want)
+		}
+	}
+}
+
+func TestIsDailyQuotaError(t *testing.T) {
+	cases := []struct {
+		code int
+		body string
+		want bool
+	}{
+		{403, `{"error":{"errors":[{"reason":"dailyLimitExceeded"}]}}`, true},
+		{429, `{"error":{"errors":[{"reason":"quotaExceeded"}]}}`, true},
+		{403, `{"error":{"errors":[{"reason":"quotaExceeded"}]}}`, true},
+		{403, `{"error":{"errors":[{"reason":"rateLimitExceeded"}]}}`, false},
+		{403, `{"error":{"errors":[{"reason":"forbidden"}]}}`, false},
+		{400, `{"error":{"errors":[{"reason":"dailyLimitExceeded"}]}}`, false},
+		{200, `{}`, false},
+	}
+
+	for _, tc := range cases {
+		resp := newTestResponse(tc.code, tc.body)
+		if got := isDailyQuotaError(resp); got != tc.want {
+			t.Errorf("isDailyQuotaError(%d %s) = %t, want %t", tc.code, tc.body, got, tc.want)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil || string(body) != tc.body {
+			t.Errorf("body = %q, %v, want %q", body, err, tc.body)
+		}
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	cases := []struct {
+		name              string
+		requestsPerMinute int
+		requests          int
+		timeout           time.Duration
+		wantErr           error
+		minElapsed        time.Duration
+		maxElapsed        time.Duration
+	}{
+		{
+			name:              "burst within a second worth of requests",
+			requestsPerMinute: 6000,
+			requests:          100,
+			maxElapsed:        50 * time.Millisecond,
+		},
+		{
+			name:              "requests past the burst wait for tokens",
+			requestsPerMinute: 600,
+			requests:          12,
+			minElapsed:        150 * time.Millisecond,
+			maxElapsed:        time.Second,
+		},
+		{
+			name:              "burst of one below a request per second",
+			requestsPerMinute: 30,
+			requests:          1,
+			maxElapsed:        50 * time.Millisecond,
+		},
+		{
+			name:              "waiting ends with the context",
+			requestsPerMinute: 60,
+			requests:          2,
+			timeout:           50 * time.Millisecond,
+			wantErr:           context.DeadlineExceeded,
+			maxElapsed:        500 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel func()
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			limiter := newRateLimiter(tc.requestsPerMinute)
+			start := time.Now()
+			var err error
+			for i := 0; i < tc.requests && err == nil; i++ {
+				err = limiter.wait(ctx)
+			}
+			elapsed := time.Since(start)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("wait() = %v, want %v", err, tc.wantErr)
+			}
+			if elapsed < tc.minElapsed || elapsed > tc.maxElapsed {
+				t.Errorf("%d requests took %s, want between %s and %s", tc.requests, elapsed, tc.minElapsed, tc.maxElapsed)
+			}
+		})
+	}
+}
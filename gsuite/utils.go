@@ -0,0 +1,121 @@
+package gsuite
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+)
+
+// handleNotFoundError removes the resource from state when the API reports
+// that it no longer exists, and wraps any other error.
+func handleNotFoundError(err error, d *schema.ResourceData, resource string) error {
+	if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+		log.Printf("[WARN] Removing %s because it's gone", resource)
+		d.SetId("")
+		return nil
+	}
+
+	return fmt.Errorf("Error reading %s: %s", resource, err)
+}
+
+// splitID splits a composite resource ID of the form "a/b" into its parts.
+func splitID(id string, parts int) ([]string, error) {
+	s := strings.SplitN(id, "/", parts)
+	if len(s) != parts {
+		return nil, fmt.Errorf("Invalid ID %q, expected %d parts separated by \"/\"", id, parts)
+	}
+	return s, nil
+}
+
+// customerID returns the customer a resource acts on: its customer_id when set,
+// otherwise the provider's customer_id.
+func customerID(d *schema.ResourceData, config *Config) string {
+	if v, ok := d.GetOk("customer_id"); ok {
+		return v.(string)
+	}
+	return config.CustomerID
+}
+
+// convertStringList converts a list read from the schema into a string slice.
+func convertStringList(v []interface{}) []string {
+	result := make([]string, len(v))
+	for i, s := range v {
+		result[i] = s.(string)
+	}
+	return result
+}
+
+func convertStringMap(v map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(v))
+	for k, s := range v {
+		result[k] = s.(string)
+	}
+	return result
+}
+
+// normalizeEmail returns the canonical form of an email, which the Admin SDK
+// treats case-insensitively and returns in lower case.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// normalizeDirectoryKey returns the canonical form of a user or group key,
+// which is either an email, including aliases, or a unique ID.
+func normalizeDirectoryKey(key string) string {
+	if strings.Contains(key, "@") {
+		return normalizeEmail(key)
+	}
+	return strings.TrimSpace(key)
+}
+
+// suppressEquivalentEmail suppresses diffs between emails only differing in
+// case or surrounding whitespace.
+func suppressEquivalentEmail(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeEmail(old) == normalizeEmail(new)
+}
+
+// suppressEquivalentDirectoryKey suppresses diffs between user or group keys
+// only differing in case or surrounding whitespace.
+func suppressEquivalentDirectoryKey(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeDirectoryKey(old) == normalizeDirectoryKey(new)
+}
+
+// validateDuration validates that a string is a Go duration, e.g. 30s.
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration: %s", k, err))
+	}
+	return
+}
+
+// validateRFC3339Time validates that a timestamp is in RFC3339 format.
+func validateRFC3339Time(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid RFC3339 timestamp: %s", k, err))
+	}
+	return
+}
+
+// suppressEquivalentRFC3339Time suppresses diffs between timestamps that
+// describe the same instant in different time zones.
+func suppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// boolPtr returns a pointer to the given bool, for API fields that
+// distinguish false from unset.
+func boolPtr(b bool) *bool {
+	return &b
+}
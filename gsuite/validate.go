@@ -0,0 +1,99 @@
+package gsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// googleOAuth2BasePath is the base URL of the tokeninfo endpoint, which
+// returns the scopes a token was granted.
+const googleOAuth2BasePath = "https://oauth2.googleapis.com/"
+
+// validateCredentials checks that the credentials work for Admin SDK calls,
+// so that problems are reported with some guidance when the provider is
+// configured, rather than as a 403 of whichever resource runs first.
+func (c *Config) validateCredentials(ctx context.Context, tokenSource oauth2.TokenSource) error {
+	token, err := tokenSource.Token()
+	if err != nil {
+		if strings.Contains(err.Error(), "unauthorized_client") {
+			return fmt.Errorf("the service account is not allowed to act as %s: authorize its client ID for the oauth_scopes in the Admin console, under Security > API controls > Domain-wide delegation: %s", c.ImpersonatedUserEmail, err)
+		}
+		if strings.Contains(err.Error(), "invalid_grant") && c.ImpersonatedUserEmail != "" {
+			return fmt.Errorf("failed to act as %s, check that impersonated_user_email is an existing user of the domain: %s", c.ImpersonatedUserEmail, err)
+		}
+		return fmt.Errorf("failed to get a token with the credentials: %s", err)
+	}
+
+	// The token goes in the body, so that it doesn't end up in access logs
+	form := url.Values{"access_token": {token.AccessToken}}
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint("oauth2", googleOAuth2BasePath)+"tokeninfo", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to check the scopes of the credentials: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("failed to check the scopes of the credentials: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var tokenInfo struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenInfo); err != nil {
+		return fmt.Errorf("failed to check the scopes of the credentials: %s", err)
+	}
+
+	granted := map[string]bool{}
+	for _, scope := range strings.Fields(tokenInfo.Scope) {
+		granted[scope] = true
+	}
+	missing := []string{}
+	for _, scope := range c.OAuthScopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	// A narrower grant only breaks the resources that need the missing scopes
+	if len(missing) > 0 {
+		log.Printf("[WARN] The credentials were not granted the scopes %s, resources needing them will fail: grant them to the credentials, e.g. with gcloud auth application-default login --scopes, or leave them out of oauth_scopes and enable_apis", strings.Join(missing, ", "))
+	}
+
+	if c.ImpersonatedUserEmail == "" {
+		if c.jwtConfig != nil || c.iamCredentials != nil {
+			log.Printf("[WARN] impersonated_user_email is not set, Admin SDK calls act as the service account itself")
+		}
+		return nil
+	}
+
+	if !granted[directory.AdminDirectoryUserScope] && !granted[directory.AdminDirectoryUserReadonlyScope] {
+		return nil
+	}
+
+	user, err := c.directory.Users.Get(c.ImpersonatedUserEmail).Fields("isAdmin,isDelegatedAdmin").Context(ctx).Do()
+	if err != nil {
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 403 {
+			return fmt.Errorf("%s is not allowed to read users, check that impersonated_user_email is an administrator: %s", c.ImpersonatedUserEmail, err)
+		}
+		return fmt.Errorf("failed to read impersonated user %s: %s", c.ImpersonatedUserEmail, err)
+	}
+	if !user.IsAdmin && !user.IsDelegatedAdmin {
+		log.Printf("[WARN] %s is not an administrator, Admin SDK calls are likely to fail", c.ImpersonatedUserEmail)
+	}
+
+	return nil
+}
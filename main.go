@@ -1,15 +1,12 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform/plugin"
-	"github.com/hashicorp/terraform/terraform"
 	"github.com/deviavir/terraform-provider-gsuite/gsuite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() terraform.ResourceProvider {
-			return gsuite.Provider()
-		},
+		GRPCProviderFunc: gsuite.ProviderServer,
 	})
 }